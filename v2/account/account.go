@@ -0,0 +1,552 @@
+// Package account keeps a live view of the balances, open orders and
+// positions of an account: it loads them through the REST API, applies the
+// events of the user data stream on top of them and reconciles the view with
+// the REST API periodically to report any drift.
+package account
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const defaultReconcileInterval = 5 * time.Minute
+
+// Balance define the balance of an asset. Spot accounts set Free and Locked,
+// futures accounts set Wallet and CrossWallet.
+type Balance struct {
+	Asset       string
+	Free        string
+	Locked      string
+	Wallet      string
+	CrossWallet string
+}
+
+func (b *Balance) equal(o *Balance) bool {
+	return decimalEqual(b.Free, o.Free) && decimalEqual(b.Locked, o.Locked) &&
+		decimalEqual(b.Wallet, o.Wallet) && decimalEqual(b.CrossWallet, o.CrossWallet)
+}
+
+// Order define an open order
+type Order struct {
+	Symbol           string
+	OrderID          int64
+	ClientOrderID    string
+	Side             string
+	PositionSide     string // only set by futures accounts
+	Type             string
+	Status           string
+	Price            string
+	StopPrice        string
+	OrigQuantity     string
+	ExecutedQuantity string
+	Time             int64
+	UpdateTime       int64
+}
+
+func (o *Order) equal(p *Order) bool {
+	return o.Status == p.Status && decimalEqual(o.Price, p.Price) && decimalEqual(o.StopPrice, p.StopPrice) &&
+		decimalEqual(o.OrigQuantity, p.OrigQuantity) && decimalEqual(o.ExecutedQuantity, p.ExecutedQuantity)
+}
+
+// Position define an open futures position
+type Position struct {
+	Symbol           string
+	Side             string // BOTH, LONG or SHORT
+	Amount           string
+	EntryPrice       string
+	UnrealizedProfit string
+	MarginType       string
+	IsolatedWallet   string
+}
+
+// equal compare the fields which only change with the trades of the position
+func (p *Position) equal(o *Position) bool {
+	return decimalEqual(p.Amount, o.Amount) && decimalEqual(p.EntryPrice, o.EntryPrice)
+}
+
+// Snapshot define the state of an account
+type Snapshot struct {
+	Balances  []Balance
+	Orders    []Order
+	Positions []Position
+}
+
+// BalanceDelta define a change of the free balance of an asset, such as a deposit or a withdrawal.
+// It is only informational, the balances are set by the absolute values the stream sends along.
+type BalanceDelta struct {
+	Asset  string
+	Change string
+}
+
+// Update define the changes of an account carried by one event of the user
+// data stream. Orders which are no longer open have their final Status.
+type Update struct {
+	Time          int64
+	Balances      []Balance
+	BalanceDeltas []BalanceDelta
+	Orders        []Order
+	Positions     []Position
+}
+
+// Drift define the differences found between the tracked state and the REST
+// API by a reconciliation, Local or Remote is nil when the item is missing
+type Drift struct {
+	Balances  []BalanceDrift
+	Orders    []OrderDrift
+	Positions []PositionDrift
+}
+
+// BalanceDrift define a balance which differs from the REST API
+type BalanceDrift struct {
+	Local  *Balance
+	Remote *Balance
+}
+
+// OrderDrift define an order which differs from the REST API
+type OrderDrift struct {
+	Local  *Order
+	Remote *Order
+}
+
+// PositionDrift define a position which differs from the REST API
+type PositionDrift struct {
+	Local  *Position
+	Remote *Position
+}
+
+// Empty tells if no difference was found
+func (d *Drift) Empty() bool {
+	return len(d.Balances) == 0 && len(d.Orders) == 0 && len(d.Positions) == 0
+}
+
+// ErrStarted is returned by Start when the tracker was already started
+var ErrStarted = errors.New("account: tracker already started")
+
+type orderKey struct {
+	symbol  string
+	orderID int64
+}
+
+type positionKey struct {
+	symbol string
+	side   string
+}
+
+// item is a value of the state with the sequence number of the event which last changed it
+type item[T any] struct {
+	value T
+	seq   int64
+}
+
+// Tracker keeps the state of an account in sync with a Source
+type Tracker struct {
+	source            Source
+	reconcileInterval time.Duration
+	backoff           common.BackoffPolicy
+	onDrift           func(drift *Drift)
+	errHandler        func(err error)
+
+	mu        sync.RWMutex
+	seq       int64
+	ready     bool
+	balances  map[string]item[Balance]
+	orders    map[orderKey]item[Order]
+	positions map[positionKey]item[Position]
+	// closed keeps the orders closed since the last reconciliation, so a
+	// snapshot fetched before they were closed does not bring them back
+	closed map[orderKey]int64
+
+	subMu     sync.Mutex
+	subID     int
+	subs      map[int]func(update *Update)
+	reconcile chan struct{}
+	cancel    context.CancelFunc
+	doneC     chan struct{}
+}
+
+// NewTracker init a tracker of the account of source, call Start to sync it
+func NewTracker(source Source) *Tracker {
+	return &Tracker{
+		source:            source,
+		reconcileInterval: defaultReconcileInterval,
+		balances:          map[string]item[Balance]{},
+		orders:            map[orderKey]item[Order]{},
+		positions:         map[positionKey]item[Position]{},
+		closed:            map[orderKey]int64{},
+		subs:              map[int]func(update *Update){},
+		reconcile:         make(chan struct{}, 1),
+	}
+}
+
+// ReconcileInterval set the interval between the reconciliations with the REST API, default 5 minutes
+func (t *Tracker) ReconcileInterval(interval time.Duration) *Tracker {
+	t.reconcileInterval = interval
+	return t
+}
+
+// Backoff set the delay between the attempts of a failed reconciliation, default common.DefaultBackoff
+func (t *Tracker) Backoff(backoff common.BackoffPolicy) *Tracker {
+	t.backoff = backoff
+	return t
+}
+
+// OnDrift set the handler called when a reconciliation finds differences,
+// the tracked state is then replaced by the one of the REST API
+func (t *Tracker) OnDrift(handler func(drift *Drift)) *Tracker {
+	t.onDrift = handler
+	return t
+}
+
+// ErrHandler set the handler called with the errors of the stream and the reconciliations
+func (t *Tracker) ErrHandler(handler func(err error)) *Tracker {
+	t.errHandler = handler
+	return t
+}
+
+// Start connect the user data stream and load the state, the state is then
+// kept in sync in the background until Stop is called or ctx is done
+func (t *Tracker) Start(ctx context.Context) error {
+	t.subMu.Lock()
+	if t.cancel != nil {
+		t.subMu.Unlock()
+		return ErrStarted
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	t.cancel = cancel
+	t.doneC = make(chan struct{})
+	t.subMu.Unlock()
+
+	fail := func(err error) error {
+		cancel()
+		t.subMu.Lock()
+		t.cancel = nil
+		close(t.doneC)
+		t.subMu.Unlock()
+		return err
+	}
+	// the stream is connected first so no event is missed while loading the state
+	doneC, stopC, err := t.source.Serve(t.apply, func(gap common.UserStreamGap) {
+		t.Reconcile()
+	}, t.handleErr)
+	if err != nil {
+		return fail(err)
+	}
+	if err := t.sync(ctx); err != nil {
+		close(stopC)
+		<-doneC
+		return fail(err)
+	}
+	go t.run(ctx, doneC, stopC)
+	return nil
+}
+
+// Stop disconnect the stream, Done is closed once it is stopped
+func (t *Tracker) Stop() {
+	t.subMu.Lock()
+	cancel, doneC := t.cancel, t.doneC
+	t.cancel = nil
+	t.subMu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-doneC
+	}
+}
+
+// Done return a channel closed once the tracker is stopped
+func (t *Tracker) Done() <-chan struct{} {
+	t.subMu.Lock()
+	defer t.subMu.Unlock()
+	return t.doneC
+}
+
+// Reconcile ask for a reconciliation with the REST API as soon as possible
+func (t *Tracker) Reconcile() {
+	select {
+	case t.reconcile <- struct{}{}:
+	default:
+	}
+}
+
+// Subscribe register handler to be called with each update applied to the
+// state, call the returned function to unsubscribe
+func (t *Tracker) Subscribe(handler func(update *Update)) (unsubscribe func()) {
+	t.subMu.Lock()
+	defer t.subMu.Unlock()
+	t.subID++
+	id := t.subID
+	t.subs[id] = handler
+	return func() {
+		t.subMu.Lock()
+		defer t.subMu.Unlock()
+		delete(t.subs, id)
+	}
+}
+
+// Snapshot return a copy of the state sorted by asset, symbol and order id
+func (t *Tracker) Snapshot() *Snapshot {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	res := &Snapshot{}
+	for _, b := range t.balances {
+		res.Balances = append(res.Balances, b.value)
+	}
+	for _, o := range t.orders {
+		res.Orders = append(res.Orders, o.value)
+	}
+	for _, p := range t.positions {
+		res.Positions = append(res.Positions, p.value)
+	}
+	sortSnapshot(res)
+	return res
+}
+
+// Balance return the balance of asset, false if it is unknown
+func (t *Tracker) Balance(asset string) (Balance, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	b, ok := t.balances[asset]
+	return b.value, ok
+}
+
+// OpenOrders return the open orders of symbol sorted by order id, all of them if symbol is empty
+func (t *Tracker) OpenOrders(symbol string) []Order {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	var res []Order
+	for k, o := range t.orders {
+		if symbol == "" || k.symbol == symbol {
+			res = append(res, o.value)
+		}
+	}
+	sortSnapshot(&Snapshot{Orders: res})
+	return res
+}
+
+// Position return the position of symbol on side, false if there is none
+func (t *Tracker) Position(symbol, side string) (Position, bool) {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	p, ok := t.positions[positionKey{symbol, side}]
+	return p.value, ok
+}
+
+// Ready tells if the state was loaded
+func (t *Tracker) Ready() bool {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.ready
+}
+
+func (t *Tracker) run(ctx context.Context, doneC, stopC chan struct{}) {
+	defer func() {
+		close(stopC)
+		<-doneC
+		t.subMu.Lock()
+		close(t.doneC)
+		t.subMu.Unlock()
+	}()
+	ticker := time.NewTicker(t.reconcileInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-doneC:
+			return
+		case <-ticker.C:
+		case <-t.reconcile:
+		}
+		backoff := t.backoff
+		if backoff == nil {
+			backoff = common.DefaultBackoff
+		}
+		for attempt := 1; ; attempt++ {
+			err := t.sync(ctx)
+			if err == nil || ctx.Err() != nil {
+				break
+			}
+			t.handleErr(err)
+			timer := time.NewTimer(backoff.Backoff(attempt))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
+		ticker.Reset(t.reconcileInterval)
+	}
+}
+
+// sync fetch a snapshot and replace the items which were not changed by an
+// event meanwhile, the differences are reported once the state is loaded
+func (t *Tracker) sync(ctx context.Context) error {
+	t.mu.RLock()
+	start := t.seq
+	t.mu.RUnlock()
+	snapshot, err := t.source.Snapshot(ctx)
+	if err != nil {
+		return err
+	}
+
+	t.mu.Lock()
+	drift := &Drift{}
+	remoteBalances := map[string]item[Balance]{}
+	for _, b := range snapshot.Balances {
+		remoteBalances[b.Asset] = item[Balance]{value: b}
+	}
+	t.balances = merge(t.balances, remoteBalances, start, nil, func(local, remote *Balance) {
+		if local == nil || remote == nil || !local.equal(remote) {
+			drift.Balances = append(drift.Balances, BalanceDrift{Local: local, Remote: remote})
+		}
+	})
+	remoteOrders := map[orderKey]item[Order]{}
+	for _, o := range snapshot.Orders {
+		remoteOrders[orderKey{o.Symbol, o.OrderID}] = item[Order]{value: o}
+	}
+	t.orders = merge(t.orders, remoteOrders, start, t.closed, func(local, remote *Order) {
+		if local == nil || remote == nil || !local.equal(remote) {
+			drift.Orders = append(drift.Orders, OrderDrift{Local: local, Remote: remote})
+		}
+	})
+	for k, seq := range t.closed {
+		if seq <= start {
+			delete(t.closed, k)
+		}
+	}
+	remotePositions := map[positionKey]item[Position]{}
+	for _, p := range snapshot.Positions {
+		remotePositions[positionKey{p.Symbol, p.Side}] = item[Position]{value: p}
+	}
+	t.positions = merge(t.positions, remotePositions, start, nil, func(local, remote *Position) {
+		if local == nil || remote == nil || !local.equal(remote) {
+			drift.Positions = append(drift.Positions, PositionDrift{Local: local, Remote: remote})
+		}
+	})
+	ready := t.ready
+	t.ready = true
+	t.mu.Unlock()
+
+	if ready && !drift.Empty() && t.onDrift != nil {
+		t.onDrift(drift)
+	}
+	return nil
+}
+
+// merge return remote with the items of local changed after start, the
+// items closed after start are left out and compare is called with the
+// other differing items
+func merge[K comparable, T any](local, remote map[K]item[T], start int64, closed map[K]int64, compare func(local, remote *T)) map[K]item[T] {
+	for k, l := range local {
+		if l.seq > start {
+			remote[k] = l
+			continue
+		}
+		l := l
+		if r, ok := remote[k]; ok {
+			compare(&l.value, &r.value)
+		} else {
+			compare(&l.value, nil)
+		}
+	}
+	for k, r := range remote {
+		if seq, ok := closed[k]; ok && seq > start {
+			delete(remote, k)
+			continue
+		}
+		if _, ok := local[k]; !ok {
+			r := r
+			compare(nil, &r.value)
+		}
+	}
+	return remote
+}
+
+// apply update the state with an update of the stream and notify the subscribers
+func (t *Tracker) apply(update *Update) {
+	t.mu.Lock()
+	t.seq++
+	seq := t.seq
+	for _, b := range update.Balances {
+		t.balances[b.Asset] = item[Balance]{value: b, seq: seq}
+	}
+	for _, o := range update.Orders {
+		k := orderKey{o.Symbol, o.OrderID}
+		if isOpen(o.Status) {
+			t.orders[k] = item[Order]{value: o, seq: seq}
+		} else {
+			delete(t.orders, k)
+			t.closed[k] = seq
+		}
+	}
+	for _, p := range update.Positions {
+		k := positionKey{p.Symbol, p.Side}
+		if common.DecimalOrZero(p.Amount).IsZero() {
+			delete(t.positions, k)
+		} else {
+			t.positions[k] = item[Position]{value: p, seq: seq}
+		}
+	}
+	t.mu.Unlock()
+
+	t.subMu.Lock()
+	subs := make([]func(update *Update), 0, len(t.subs))
+	for _, sub := range t.subs {
+		subs = append(subs, sub)
+	}
+	t.subMu.Unlock()
+	for _, sub := range subs {
+		sub(update)
+	}
+}
+
+func (t *Tracker) handleErr(err error) {
+	if t.errHandler != nil {
+		t.errHandler(err)
+	}
+}
+
+// isOpen tells if an order with status is still open
+func isOpen(status string) bool {
+	switch status {
+	case "NEW", "PARTIALLY_FILLED", "PENDING_NEW":
+		return true
+	}
+	return false
+}
+
+func decimalEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+	x, err := common.ParseDecimal(a)
+	if err != nil {
+		return false
+	}
+	y, err := common.ParseDecimal(b)
+	if err != nil {
+		return false
+	}
+	return x.Equal(y)
+}
+
+func sortSnapshot(s *Snapshot) {
+	sort.Slice(s.Balances, func(i, j int) bool { return s.Balances[i].Asset < s.Balances[j].Asset })
+	sort.Slice(s.Orders, func(i, j int) bool {
+		if s.Orders[i].Symbol != s.Orders[j].Symbol {
+			return s.Orders[i].Symbol < s.Orders[j].Symbol
+		}
+		return s.Orders[i].OrderID < s.Orders[j].OrderID
+	})
+	sort.Slice(s.Positions, func(i, j int) bool {
+		if s.Positions[i].Symbol != s.Positions[j].Symbol {
+			return s.Positions[i].Symbol < s.Positions[j].Symbol
+		}
+		return s.Positions[i].Side < s.Positions[j].Side
+	})
+}
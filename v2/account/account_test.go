@@ -0,0 +1,261 @@
+package account
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// fakeSource serves the snapshots set by the test and hands the stream handlers to it
+type fakeSource struct {
+	mu         sync.Mutex
+	snapshot   *Snapshot
+	err        error
+	fetched    chan struct{}
+	fetch      func()
+	handler    func(update *Update)
+	gapHandler func(gap common.UserStreamGap)
+	stopped    bool
+}
+
+func (f *fakeSource) Snapshot(ctx context.Context) (*Snapshot, error) {
+	f.mu.Lock()
+	fetch := f.fetch
+	f.mu.Unlock()
+	if fetch != nil {
+		fetch()
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.err != nil {
+		return nil, f.err
+	}
+	select {
+	case f.fetched <- struct{}{}:
+	default:
+	}
+	s := *f.snapshot
+	return &s, nil
+}
+
+func (f *fakeSource) Serve(handler func(update *Update), gapHandler func(gap common.UserStreamGap), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	f.handler = handler
+	f.gapHandler = gapHandler
+	doneC, stopC = make(chan struct{}), make(chan struct{})
+	go func() {
+		<-stopC
+		f.mu.Lock()
+		f.stopped = true
+		f.mu.Unlock()
+		close(doneC)
+	}()
+	return doneC, stopC, nil
+}
+
+func (f *fakeSource) set(snapshot *Snapshot) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.snapshot = snapshot
+}
+
+type accountTestSuite struct {
+	suite.Suite
+	source  *fakeSource
+	tracker *Tracker
+	drifts  chan *Drift
+}
+
+func TestAccount(t *testing.T) {
+	suite.Run(t, new(accountTestSuite))
+}
+
+func (s *accountTestSuite) SetupTest() {
+	s.source = &fakeSource{
+		fetched: make(chan struct{}, 1),
+		snapshot: &Snapshot{
+			Balances: []Balance{{Asset: "BTC", Free: "1.00000000", Locked: "0.00000000"}},
+			Orders:   []Order{{Symbol: "BTCUSDT", OrderID: 1, Status: "NEW", Price: "100", OrigQuantity: "1"}},
+		},
+	}
+	s.drifts = make(chan *Drift, 10)
+	s.tracker = NewTracker(s.source).
+		ReconcileInterval(time.Hour).
+		Backoff(common.ConstantBackoff(time.Millisecond)).
+		OnDrift(func(drift *Drift) { s.drifts <- drift })
+}
+
+func (s *accountTestSuite) TearDownTest() {
+	s.tracker.Stop()
+}
+
+func (s *accountTestSuite) reconcile() {
+	s.Require().NoError(s.tracker.sync(context.Background()))
+}
+
+func (s *accountTestSuite) TestApply() {
+	s.Require().NoError(s.tracker.Start(context.Background()))
+	s.True(s.tracker.Ready())
+	s.Equal(s.source.snapshot, s.tracker.Snapshot())
+	s.ErrorIs(s.tracker.Start(context.Background()), ErrStarted)
+
+	updates := make(chan *Update, 10)
+	unsubscribe := s.tracker.Subscribe(func(update *Update) { updates <- update })
+	s.source.handler(SpotUpdate(&binance.WsUserDataEvent{
+		Event:         binance.UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: binance.WsBalanceUpdate{Asset: "BTC", Change: "0.5"},
+	}))
+	update := <-updates
+	s.Equal([]BalanceDelta{{Asset: "BTC", Change: "0.5"}}, update.BalanceDeltas)
+	b, ok := s.tracker.Balance("BTC")
+	s.True(ok)
+	s.Equal("1.00000000", b.Free)
+
+	s.source.handler(SpotUpdate(&binance.WsUserDataEvent{
+		Event:       binance.UserDataEventTypeExecutionReport,
+		OrderUpdate: binance.WsOrderUpdate{Symbol: "BTCUSDT", Id: 2, Status: "NEW", Price: "90", Volume: "2"},
+	}))
+	s.Len(s.tracker.OpenOrders("BTCUSDT"), 2)
+	s.source.handler(SpotUpdate(&binance.WsUserDataEvent{
+		Event:       binance.UserDataEventTypeExecutionReport,
+		OrderUpdate: binance.WsOrderUpdate{Symbol: "BTCUSDT", Id: 1, Status: "FILLED", Price: "100", Volume: "1", FilledVolume: "1"},
+	}))
+	s.Equal([]Order{{Symbol: "BTCUSDT", OrderID: 2, Status: "NEW", Price: "90", OrigQuantity: "2"}}, s.tracker.OpenOrders(""))
+	s.Len(updates, 2)
+
+	unsubscribe()
+	s.source.handler(SpotUpdate(&binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeOutboundAccountPosition,
+		AccountUpdate: binance.WsAccountUpdateList{WsAccountUpdates: []binance.WsAccountUpdate{
+			{Asset: "USDT", Free: "10", Locked: "0"},
+		}},
+	}))
+	s.Len(updates, 2)
+	s.Len(s.tracker.Snapshot().Balances, 2)
+	s.Nil(SpotUpdate(&binance.WsUserDataEvent{Event: binance.UserDataEventTypeListStatus}))
+}
+
+func (s *accountTestSuite) TestBalanceUpdateOrder() {
+	s.Require().NoError(s.tracker.Start(context.Background()))
+	deposit := &binance.WsUserDataEvent{
+		Event:         binance.UserDataEventTypeBalanceUpdate,
+		BalanceUpdate: binance.WsBalanceUpdate{Asset: "BTC", Change: "0.5"},
+	}
+	position := &binance.WsUserDataEvent{
+		Event: binance.UserDataEventTypeOutboundAccountPosition,
+		AccountUpdate: binance.WsAccountUpdateList{WsAccountUpdates: []binance.WsAccountUpdate{
+			{Asset: "BTC", Free: "1.50000000", Locked: "0.00000000"},
+		}},
+	}
+	for _, events := range [][]*binance.WsUserDataEvent{{deposit, position}, {position, deposit}} {
+		s.reconcile()
+		for _, e := range events {
+			s.source.handler(SpotUpdate(e))
+		}
+		b, ok := s.tracker.Balance("BTC")
+		s.True(ok)
+		s.Equal("1.50000000", b.Free)
+	}
+}
+
+func (s *accountTestSuite) TestDrift() {
+	s.Require().NoError(s.tracker.Start(context.Background()))
+	// an event applied while the snapshot is fetched is kept
+	s.source.mu.Lock()
+	s.source.fetch = func() {
+		s.source.handler(&Update{Orders: []Order{{Symbol: "BTCUSDT", OrderID: 1, Status: "CANCELED"}}})
+	}
+	s.source.mu.Unlock()
+	s.source.set(&Snapshot{
+		Balances: []Balance{{Asset: "BTC", Free: "1", Locked: "0.1"}},
+		Orders:   []Order{{Symbol: "BTCUSDT", OrderID: 1, Status: "NEW", Price: "100", OrigQuantity: "1"}},
+	})
+	s.reconcile()
+	drift := <-s.drifts
+	s.Equal([]BalanceDrift{{
+		Local:  &Balance{Asset: "BTC", Free: "1.00000000", Locked: "0.00000000"},
+		Remote: &Balance{Asset: "BTC", Free: "1", Locked: "0.1"},
+	}}, drift.Balances)
+	s.Empty(drift.Orders)
+	s.Empty(s.tracker.OpenOrders(""))
+	b, _ := s.tracker.Balance("BTC")
+	s.Equal("0.1", b.Locked)
+
+	s.source.mu.Lock()
+	s.source.fetch = nil
+	s.source.mu.Unlock()
+	s.source.set(&Snapshot{Balances: []Balance{{Asset: "BTC", Free: "1", Locked: "0.1"}}})
+	s.reconcile()
+	s.Len(s.drifts, 0)
+	s.Equal([]Balance{{Asset: "BTC", Free: "1", Locked: "0.1"}}, s.tracker.Snapshot().Balances)
+}
+
+func (s *accountTestSuite) TestPositions() {
+	s.source.set(&Snapshot{})
+	s.Require().NoError(s.tracker.Start(context.Background()))
+	s.source.handler(FuturesUpdate(&futures.WsUserDataEvent{
+		Event: futures.UserDataEventTypeAccountUpdate,
+		AccountUpdate: futures.WsAccountUpdate{
+			Balances:  []futures.WsBalance{{Asset: "USDT", Balance: "100", CrossWalletBalance: "100"}},
+			Positions: []futures.WsPosition{{Symbol: "BTCUSDT", Side: futures.PositionSideTypeBoth, Amount: "0.1", EntryPrice: "30000"}},
+		},
+	}))
+	p, ok := s.tracker.Position("BTCUSDT", "BOTH")
+	s.True(ok)
+	s.Equal("0.1", p.Amount)
+	b, _ := s.tracker.Balance("USDT")
+	s.Equal("100", b.Wallet)
+
+	s.source.handler(FuturesUpdate(&futures.WsUserDataEvent{
+		Event: futures.UserDataEventTypeAccountUpdate,
+		AccountUpdate: futures.WsAccountUpdate{
+			Positions: []futures.WsPosition{{Symbol: "BTCUSDT", Side: futures.PositionSideTypeBoth, Amount: "0"}},
+		},
+	}))
+	_, ok = s.tracker.Position("BTCUSDT", "BOTH")
+	s.False(ok)
+}
+
+func (s *accountTestSuite) TestGap() {
+	errC := make(chan error, 1)
+	s.tracker.ErrHandler(func(err error) {
+		select {
+		case errC <- err:
+		default:
+		}
+	})
+	s.Require().NoError(s.tracker.Start(context.Background()))
+	<-s.source.fetched
+
+	s.source.mu.Lock()
+	s.source.err = errors.New("timeout")
+	s.source.mu.Unlock()
+	s.source.gapHandler(common.UserStreamGap{})
+	s.EqualError(<-errC, "timeout")
+	s.source.mu.Lock()
+	s.source.err = nil
+	s.source.mu.Unlock()
+	select {
+	case <-s.source.fetched:
+	case <-time.After(time.Second):
+		s.Fail("no retry")
+	}
+
+	s.tracker.Stop()
+	<-s.tracker.Done()
+	s.True(s.source.stopped)
+}
+
+func (s *accountTestSuite) TestStartError() {
+	s.source.err = errors.New("unauthorized")
+	s.EqualError(s.tracker.Start(context.Background()), "unauthorized")
+	s.True(s.source.stopped)
+	s.Require().NoError(func() error { s.source.err = nil; return s.tracker.Start(context.Background()) }())
+}
@@ -0,0 +1,239 @@
+package account
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Source connects a tracker to the REST API and the user data stream of an account
+type Source interface {
+	// Snapshot fetch the balances, open orders and positions of the account
+	Snapshot(ctx context.Context) (*Snapshot, error)
+	// Serve start the user data stream of the account, gapHandler is called
+	// once the stream is running again after it went down
+	Serve(handler func(update *Update), gapHandler func(gap common.UserStreamGap), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+}
+
+// serveUserStream start stream with its events converted to updates, convert returns nil for the events to ignore
+func serveUserStream[E any](stream *common.UserStream[E], convert func(event E) *Update, handler func(update *Update),
+	gapHandler func(gap common.UserStreamGap), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	stream.Handler = func(event E) {
+		if update := convert(event); update != nil {
+			handler(update)
+		}
+	}
+	stream.OnGap = gapHandler
+	stream.ErrHandler = errHandler
+	if err := stream.Start(context.Background()); err != nil {
+		return nil, nil, err
+	}
+	doneC = make(chan struct{})
+	stopC = make(chan struct{})
+	go func() {
+		defer close(doneC)
+		select {
+		case <-stopC:
+			stream.Stop()
+		case <-stream.Done():
+		}
+	}()
+	return doneC, stopC, nil
+}
+
+// SpotSource is the Source of a spot account
+type SpotSource struct {
+	c *binance.Client
+}
+
+// NewSpotSource init a source of the spot account of c
+func NewSpotSource(c *binance.Client) *SpotSource {
+	return &SpotSource{c: c}
+}
+
+// Snapshot implements Source
+func (s *SpotSource) Snapshot(ctx context.Context) (*Snapshot, error) {
+	account, err := s.c.NewGetAccountService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := s.c.NewListOpenOrdersService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &Snapshot{}
+	for _, b := range account.Balances {
+		res.Balances = append(res.Balances, Balance{Asset: b.Asset, Free: b.Free, Locked: b.Locked})
+	}
+	for _, o := range orders {
+		res.Orders = append(res.Orders, Order{
+			Symbol:           o.Symbol,
+			OrderID:          o.OrderID,
+			ClientOrderID:    o.ClientOrderID,
+			Side:             string(o.Side),
+			Type:             string(o.Type),
+			Status:           string(o.Status),
+			Price:            o.Price,
+			StopPrice:        o.StopPrice,
+			OrigQuantity:     o.OrigQuantity,
+			ExecutedQuantity: o.ExecutedQuantity,
+			Time:             o.Time,
+			UpdateTime:       o.UpdateTime,
+		})
+	}
+	return res, nil
+}
+
+// Serve implements Source
+func (s *SpotSource) Serve(handler func(update *Update), gapHandler func(gap common.UserStreamGap), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	return serveUserStream(s.c.NewUserStream(), SpotUpdate, handler, gapHandler, errHandler)
+}
+
+// SpotUpdate convert a spot user data event to an update, nil if it does not change the account
+func SpotUpdate(e *binance.WsUserDataEvent) *Update {
+	switch e.Event {
+	case binance.UserDataEventTypeOutboundAccountPosition:
+		update := &Update{Time: e.AccountUpdate.AccountUpdateTime}
+		for _, b := range e.AccountUpdate.WsAccountUpdates {
+			update.Balances = append(update.Balances, Balance{Asset: b.Asset, Free: b.Free, Locked: b.Locked})
+		}
+		return update
+	case binance.UserDataEventTypeBalanceUpdate:
+		return &Update{
+			Time:          e.BalanceUpdate.TransactionTime,
+			BalanceDeltas: []BalanceDelta{{Asset: e.BalanceUpdate.Asset, Change: e.BalanceUpdate.Change}},
+		}
+	case binance.UserDataEventTypeExecutionReport:
+		o := e.OrderUpdate
+		return &Update{
+			Time: o.TransactionTime,
+			Orders: []Order{{
+				Symbol:           o.Symbol,
+				OrderID:          o.Id,
+				ClientOrderID:    o.ClientOrderId,
+				Side:             o.Side,
+				Type:             o.Type,
+				Status:           o.Status,
+				Price:            o.Price,
+				StopPrice:        o.StopPrice,
+				OrigQuantity:     o.Volume,
+				ExecutedQuantity: o.FilledVolume,
+				Time:             o.CreateTime,
+				UpdateTime:       o.TransactionTime,
+			}},
+		}
+	}
+	return nil
+}
+
+// FuturesSource is the Source of a USD-M futures account
+type FuturesSource struct {
+	c *futures.Client
+}
+
+// NewFuturesSource init a source of the USD-M futures account of c
+func NewFuturesSource(c *futures.Client) *FuturesSource {
+	return &FuturesSource{c: c}
+}
+
+// Snapshot implements Source
+func (s *FuturesSource) Snapshot(ctx context.Context) (*Snapshot, error) {
+	balances, err := s.c.NewGetBalanceService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	orders, err := s.c.NewListOpenOrdersService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := s.c.NewGetPositionRiskService().Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &Snapshot{}
+	for _, b := range balances {
+		res.Balances = append(res.Balances, Balance{Asset: b.Asset, Wallet: b.Balance, CrossWallet: b.CrossWalletBalance})
+	}
+	for _, o := range orders {
+		res.Orders = append(res.Orders, Order{
+			Symbol:           o.Symbol,
+			OrderID:          o.OrderID,
+			ClientOrderID:    o.ClientOrderID,
+			Side:             string(o.Side),
+			PositionSide:     string(o.PositionSide),
+			Type:             string(o.Type),
+			Status:           string(o.Status),
+			Price:            o.Price,
+			StopPrice:        o.StopPrice,
+			OrigQuantity:     o.OrigQuantity,
+			ExecutedQuantity: o.ExecutedQuantity,
+			Time:             o.Time,
+			UpdateTime:       o.UpdateTime,
+		})
+	}
+	for _, p := range positions {
+		if common.DecimalOrZero(p.PositionAmt).IsZero() {
+			continue
+		}
+		res.Positions = append(res.Positions, Position{
+			Symbol:           p.Symbol,
+			Side:             p.PositionSide,
+			Amount:           p.PositionAmt,
+			EntryPrice:       p.EntryPrice,
+			UnrealizedProfit: p.UnRealizedProfit,
+			MarginType:       p.MarginType,
+			IsolatedWallet:   p.IsolatedWallet,
+		})
+	}
+	return res, nil
+}
+
+// Serve implements Source
+func (s *FuturesSource) Serve(handler func(update *Update), gapHandler func(gap common.UserStreamGap), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	return serveUserStream(s.c.NewUserStream(), FuturesUpdate, handler, gapHandler, errHandler)
+}
+
+// FuturesUpdate convert a USD-M futures user data event to an update, nil if it does not change the account
+func FuturesUpdate(e *futures.WsUserDataEvent) *Update {
+	switch e.Event {
+	case futures.UserDataEventTypeAccountUpdate:
+		update := &Update{Time: e.TransactionTime}
+		for _, b := range e.AccountUpdate.Balances {
+			update.Balances = append(update.Balances, Balance{Asset: b.Asset, Wallet: b.Balance, CrossWallet: b.CrossWalletBalance})
+		}
+		for _, p := range e.AccountUpdate.Positions {
+			update.Positions = append(update.Positions, Position{
+				Symbol:           p.Symbol,
+				Side:             string(p.Side),
+				Amount:           p.Amount,
+				EntryPrice:       p.EntryPrice,
+				UnrealizedProfit: p.UnrealizedPnL,
+				MarginType:       string(p.MarginType),
+				IsolatedWallet:   p.IsolatedWallet,
+			})
+		}
+		return update
+	case futures.UserDataEventTypeOrderTradeUpdate:
+		o := e.OrderTradeUpdate
+		return &Update{
+			Time: e.TransactionTime,
+			Orders: []Order{{
+				Symbol:           o.Symbol,
+				OrderID:          o.ID,
+				ClientOrderID:    o.ClientOrderID,
+				Side:             string(o.Side),
+				PositionSide:     string(o.PositionSide),
+				Type:             string(o.Type),
+				Status:           string(o.Status),
+				Price:            o.OriginalPrice,
+				StopPrice:        o.StopPrice,
+				OrigQuantity:     o.OriginalQty,
+				ExecutedQuantity: o.AccumulatedFilledQty,
+				UpdateTime:       o.TradeTime,
+			}},
+		}
+	}
+	return nil
+}
@@ -0,0 +1,294 @@
+package binance
+
+import (
+	"context"
+	stdjson "encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// CancelReplaceService cancel an order and place a new order on the same symbol atomically
+type CancelReplaceService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	cancelReplaceMode       CancelReplaceModeType
+	timeInForce             *TimeInForceType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	cancelNewClientOrderID  *string
+	cancelOrigClientOrderID *string
+	cancelOrderID           *int64
+	newClientOrderID        *string
+	strategyID              *int64
+	strategyType            *int64
+	stopPrice               *string
+	trailingDelta           *int64
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *STPModeType
+	cancelRestrictions      *CancelRestrictionsType
+}
+
+// Symbol set symbol
+func (s *CancelReplaceService) Symbol(symbol string) *CancelReplaceService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side of the new order
+func (s *CancelReplaceService) Side(side SideType) *CancelReplaceService {
+	s.side = side
+	return s
+}
+
+// Type set type of the new order
+func (s *CancelReplaceService) Type(orderType OrderType) *CancelReplaceService {
+	s.orderType = orderType
+	return s
+}
+
+// CancelReplaceMode set cancelReplaceMode, with STOP_ON_FAILURE the new order
+// is not placed if the cancel request fails
+func (s *CancelReplaceService) CancelReplaceMode(mode CancelReplaceModeType) *CancelReplaceService {
+	s.cancelReplaceMode = mode
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CancelReplaceService) TimeInForce(timeInForce TimeInForceType) *CancelReplaceService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CancelReplaceService) Quantity(quantity string) *CancelReplaceService {
+	s.quantity = &quantity
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *CancelReplaceService) QuoteOrderQty(quoteOrderQty string) *CancelReplaceService {
+	s.quoteOrderQty = &quoteOrderQty
+	return s
+}
+
+// Price set price
+func (s *CancelReplaceService) Price(price string) *CancelReplaceService {
+	s.price = &price
+	return s
+}
+
+// CancelNewClientOrderID set cancelNewClientOrderId, the new id of the canceled order
+func (s *CancelReplaceService) CancelNewClientOrderID(cancelNewClientOrderID string) *CancelReplaceService {
+	s.cancelNewClientOrderID = &cancelNewClientOrderID
+	return s
+}
+
+// CancelOrigClientOrderID set cancelOrigClientOrderId, the client order id of the order to cancel
+func (s *CancelReplaceService) CancelOrigClientOrderID(cancelOrigClientOrderID string) *CancelReplaceService {
+	s.cancelOrigClientOrderID = &cancelOrigClientOrderID
+	return s
+}
+
+// CancelOrderID set cancelOrderId, the id of the order to cancel
+func (s *CancelReplaceService) CancelOrderID(cancelOrderID int64) *CancelReplaceService {
+	s.cancelOrderID = &cancelOrderID
+	return s
+}
+
+// NewClientOrderID set newClientOrderId of the new order
+func (s *CancelReplaceService) NewClientOrderID(newClientOrderID string) *CancelReplaceService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StrategyID set strategyId
+func (s *CancelReplaceService) StrategyID(strategyID int64) *CancelReplaceService {
+	s.strategyID = &strategyID
+	return s
+}
+
+// StrategyType set strategyType, it must be at least 1000000
+func (s *CancelReplaceService) StrategyType(strategyType int64) *CancelReplaceService {
+	s.strategyType = &strategyType
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *CancelReplaceService) StopPrice(stopPrice string) *CancelReplaceService {
+	s.stopPrice = &stopPrice
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *CancelReplaceService) TrailingDelta(trailingDelta int64) *CancelReplaceService {
+	s.trailingDelta = &trailingDelta
+	return s
+}
+
+// IcebergQuantity set icebergQty
+func (s *CancelReplaceService) IcebergQuantity(icebergQuantity string) *CancelReplaceService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CancelReplaceService) NewOrderRespType(newOrderRespType NewOrderRespType) *CancelReplaceService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CancelReplaceService) SelfTradePreventionMode(mode STPModeType) *CancelReplaceService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// CancelRestrictions set cancelRestrictions, the order is only canceled if it has the given status
+func (s *CancelReplaceService) CancelRestrictions(cancelRestrictions CancelRestrictionsType) *CancelReplaceService {
+	s.cancelRestrictions = &cancelRestrictions
+	return s
+}
+
+// CancelReplaceResponse define cancel-replace response. When a step fails
+// its error is set instead of its response.
+type CancelReplaceResponse struct {
+	CancelResult     CancelReplaceResultType
+	NewOrderResult   CancelReplaceResultType
+	CancelResponse   *CancelOrderResponse
+	CancelError      *common.APIError
+	NewOrderResponse *CreateOrderResponse
+	NewOrderError    *common.APIError
+}
+
+// cancelReplaceData is the body of the response, or the data of the error when a step failed
+type cancelReplaceData struct {
+	CancelResult     CancelReplaceResultType `json:"cancelResult"`
+	NewOrderResult   CancelReplaceResultType `json:"newOrderResult"`
+	CancelResponse   stdjson.RawMessage      `json:"cancelResponse"`
+	NewOrderResponse stdjson.RawMessage      `json:"newOrderResponse"`
+}
+
+func (d *cancelReplaceData) decode() (res *CancelReplaceResponse, err error) {
+	res = &CancelReplaceResponse{
+		CancelResult:   d.CancelResult,
+		NewOrderResult: d.NewOrderResult,
+	}
+	if len(d.CancelResponse) > 0 && string(d.CancelResponse) != "null" {
+		if d.CancelResult == CancelReplaceResultTypeFailure {
+			res.CancelError = new(common.APIError)
+			err = json.Unmarshal(d.CancelResponse, res.CancelError)
+		} else {
+			res.CancelResponse = new(CancelOrderResponse)
+			err = json.Unmarshal(d.CancelResponse, res.CancelResponse)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(d.NewOrderResponse) > 0 && string(d.NewOrderResponse) != "null" {
+		if d.NewOrderResult == CancelReplaceResultTypeFailure {
+			res.NewOrderError = new(common.APIError)
+			err = json.Unmarshal(d.NewOrderResponse, res.NewOrderError)
+		} else {
+			res.NewOrderResponse = new(CreateOrderResponse)
+			err = json.Unmarshal(d.NewOrderResponse, res.NewOrderResponse)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// Do send request. When a step fails, the error of the request is returned
+// along with the response reporting the result of each step, so a canceled
+// order whose new order failed can be told from an order left untouched.
+func (s *CancelReplaceService) Do(ctx context.Context, opts ...RequestOption) (res *CancelReplaceResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: "/api/v3/order/cancelReplace",
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":            s.symbol,
+		"side":              s.side,
+		"type":              s.orderType,
+		"cancelReplaceMode": s.cancelReplaceMode,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.quantity != nil {
+		m["quantity"] = *s.quantity
+	}
+	if s.quoteOrderQty != nil {
+		m["quoteOrderQty"] = *s.quoteOrderQty
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.cancelNewClientOrderID != nil {
+		m["cancelNewClientOrderId"] = *s.cancelNewClientOrderID
+	}
+	if s.cancelOrigClientOrderID != nil {
+		m["cancelOrigClientOrderId"] = *s.cancelOrigClientOrderID
+	}
+	if s.cancelOrderID != nil {
+		m["cancelOrderId"] = *s.cancelOrderID
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.strategyID != nil {
+		m["strategyId"] = *s.strategyID
+	}
+	if s.strategyType != nil {
+		m["strategyType"] = *s.strategyType
+	}
+	if s.stopPrice != nil {
+		m["stopPrice"] = *s.stopPrice
+	}
+	if s.trailingDelta != nil {
+		m["trailingDelta"] = *s.trailingDelta
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	if s.cancelRestrictions != nil {
+		m["cancelRestrictions"] = *s.cancelRestrictions
+	}
+	r.setFormParams(m)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		apiErr, ok := common.AsAPIError(err)
+		if !ok || len(apiErr.Data) == 0 {
+			return nil, err
+		}
+		d := new(cancelReplaceData)
+		if e := json.Unmarshal(apiErr.Data, d); e != nil {
+			return nil, err
+		}
+		res, e := d.decode()
+		if e != nil {
+			return nil, err
+		}
+		return res, err
+	}
+	d := new(cancelReplaceData)
+	err = json.Unmarshal(data, d)
+	if err != nil {
+		return nil, err
+	}
+	return d.decode()
+}
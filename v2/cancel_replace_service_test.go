@@ -0,0 +1,169 @@
+package binance
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type cancelReplaceServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestCancelReplaceService(t *testing.T) {
+	suite.Run(t, new(cancelReplaceServiceTestSuite))
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelReplace() {
+	data := []byte(`{
+		"cancelResult": "SUCCESS",
+		"newOrderResult": "SUCCESS",
+		"cancelResponse": {
+			"symbol": "BTCUSDT",
+			"origClientOrderId": "DnLo3vTAQcjha43lAZhZ0y",
+			"orderId": 9,
+			"orderListId": -1,
+			"clientOrderId": "osxN3JXAtJvKvCqGeMWMVR",
+			"transactTime": 1684804350068,
+			"price": "0.01000000",
+			"origQty": "0.000100",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "CANCELED",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		},
+		"newOrderResponse": {
+			"symbol": "BTCUSDT",
+			"orderId": 10,
+			"orderListId": -1,
+			"clientOrderId": "wOceeeOzNORyLiQfw7jd8S",
+			"transactTime": 1652928801803,
+			"price": "0.02000000",
+			"origQty": "0.040000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY",
+			"fills": []
+		}
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":             "BTCUSDT",
+			"side":               SideTypeBuy,
+			"type":               OrderTypeLimit,
+			"cancelReplaceMode":  CancelReplaceModeTypeStopOnFailure,
+			"timeInForce":        TimeInForceTypeGTC,
+			"quantity":           "0.04",
+			"price":              "0.02",
+			"cancelOrderId":      int64(9),
+			"cancelRestrictions": CancelRestrictionsTypeOnlyNew,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelReplaceService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		CancelReplaceMode(CancelReplaceModeTypeStopOnFailure).TimeInForce(TimeInForceTypeGTC).
+		Quantity("0.04").Price("0.02").CancelOrderID(9).CancelRestrictions(CancelRestrictionsTypeOnlyNew).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(CancelReplaceResultTypeSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultTypeSuccess, res.NewOrderResult)
+	r.Equal(OrderStatusTypeCanceled, res.CancelResponse.Status)
+	r.Equal(int64(9), res.CancelResponse.OrderID)
+	r.Equal(int64(10), res.NewOrderResponse.OrderID)
+	r.Nil(res.CancelError)
+	r.Nil(res.NewOrderError)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestNewOrderFailed() {
+	data := []byte(`{
+		"code": -2021,
+		"msg": "Order cancel-replace partially failed.",
+		"data": {
+			"cancelResult": "SUCCESS",
+			"newOrderResult": "FAILURE",
+			"cancelResponse": {
+				"symbol": "BTCUSDT",
+				"origClientOrderId": "86M8erehfExV8z2RC8Zo8k",
+				"orderId": 3,
+				"orderListId": -1,
+				"clientOrderId": "G1kLo6aDv2KGNTFcjfTSFq",
+				"price": "0.006123",
+				"origQty": "10000.000000",
+				"executedQty": "0.000000",
+				"cummulativeQuoteQty": "0.000000",
+				"status": "CANCELED",
+				"timeInForce": "GTC",
+				"type": "LIMIT_MAKER",
+				"side": "SELL"
+			},
+			"newOrderResponse": {
+				"code": -2010,
+				"msg": "Order would immediately match and take."
+			}
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusConflict)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimitMaker).
+		CancelReplaceMode(CancelReplaceModeTypeAllowFailure).Quantity("10000").Price("0.006").CancelOrderID(3).
+		Do(newContext())
+	r := s.r()
+	r.Error(err)
+	apiErr, ok := common.AsAPIError(err)
+	r.True(ok)
+	r.Equal(int64(-2021), apiErr.Code)
+	r.NotNil(res)
+	r.Equal(CancelReplaceResultTypeSuccess, res.CancelResult)
+	r.Equal(CancelReplaceResultTypeFailure, res.NewOrderResult)
+	r.Equal(OrderStatusTypeCanceled, res.CancelResponse.Status)
+	r.Nil(res.NewOrderResponse)
+	r.Equal(int64(-2010), res.NewOrderError.Code)
+	r.Equal("Order would immediately match and take.", res.NewOrderError.Message)
+}
+
+func (s *cancelReplaceServiceTestSuite) TestCancelFailed() {
+	data := []byte(`{
+		"code": -2022,
+		"msg": "Order cancel-replace failed.",
+		"data": {
+			"cancelResult": "FAILURE",
+			"newOrderResult": "NOT_ATTEMPTED",
+			"cancelResponse": {
+				"code": -2011,
+				"msg": "Unknown order sent."
+			},
+			"newOrderResponse": null
+		}
+	}`)
+	s.mockDo(data, nil, http.StatusBadRequest)
+	defer s.assertDo()
+
+	res, err := s.client.NewCancelReplaceService().Symbol("BTCUSDT").Side(SideTypeSell).Type(OrderTypeLimit).
+		CancelReplaceMode(CancelReplaceModeTypeStopOnFailure).CancelOrderID(3).
+		Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.Equal(CancelReplaceResultTypeNotAttempted, res.NewOrderResult)
+	r.Equal(int64(-2011), res.CancelError.Code)
+	r.Nil(res.CancelResponse)
+	r.Nil(res.NewOrderResponse)
+	r.Nil(res.NewOrderError)
+
+	s.mockDo([]byte(`{"code": -1102, "msg": "Mandatory parameter 'cancelReplaceMode' was not sent."}`), nil, http.StatusBadRequest)
+	res, err = s.client.NewCancelReplaceService().Symbol("BTCUSDT").Do(newContext())
+	r.Error(err)
+	r.Nil(res)
+}
@@ -0,0 +1,359 @@
+// Package candle builds bars of any interval, volume bars, tick bars and
+// dollar bars from trade streams, or merges klines into longer ones.
+package candle
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ErrNotTimeBar is returned when klines are added to a builder of volume, tick or dollar bars
+var ErrNotTimeBar = errors.New("candle: klines can only be merged into time bars")
+
+// BarType define the rule closing the bars of a Builder
+type BarType string
+
+// Bar types
+const (
+	// BarTypeTime close the bars every interval
+	BarTypeTime BarType = "TIME"
+	// BarTypeVolume close the bars once their volume reaches the threshold
+	BarTypeVolume BarType = "VOLUME"
+	// BarTypeTick close the bars once their number of trades reaches the threshold
+	BarTypeTick BarType = "TICK"
+	// BarTypeDollar close the bars once their quote volume reaches the threshold
+	BarTypeDollar BarType = "DOLLAR"
+)
+
+// Candle define a bar, it has the fields of the klines of all markets so that
+// it can be converted to them, e.g. (*binance.Kline)(candle)
+type Candle struct {
+	OpenTime                 int64  `json:"openTime"`
+	Open                     string `json:"open"`
+	High                     string `json:"high"`
+	Low                      string `json:"low"`
+	Close                    string `json:"close"`
+	Volume                   string `json:"volume"`
+	CloseTime                int64  `json:"closeTime"`
+	QuoteAssetVolume         string `json:"quoteAssetVolume"`
+	TradeNum                 int64  `json:"tradeNum"`
+	TakerBuyBaseAssetVolume  string `json:"takerBuyBaseAssetVolume"`
+	TakerBuyQuoteAssetVolume string `json:"takerBuyQuoteAssetVolume"`
+}
+
+// Trade define a trade in the format shared by all markets
+type Trade struct {
+	Time     int64
+	Price    common.Decimal
+	Quantity common.Decimal
+	// Count is the number of trades, more than 1 for an aggregate trade
+	Count        int64
+	IsBuyerMaker bool
+}
+
+// Handler handle a bar, closed tells if the bar is completed, else it is in progress
+type Handler func(candle *Candle, closed bool)
+
+// bar accumulate the trades or klines of a bar
+type bar struct {
+	openTime, closeTime    int64
+	open, high, low, close common.Decimal
+	volume, quoteVolume    common.Decimal
+	takerBase, takerQuote  common.Decimal
+	trades                 int64
+	empty                  bool
+}
+
+func newBar(openTime, closeTime int64) *bar {
+	return &bar{openTime: openTime, closeTime: closeTime, empty: true}
+}
+
+func (b *bar) addPrices(open, high, low, close common.Decimal) {
+	if b.empty {
+		b.open, b.high, b.low = open, high, low
+		b.empty = false
+	}
+	if high.Cmp(b.high) > 0 {
+		b.high = high
+	}
+	if low.Cmp(b.low) < 0 {
+		b.low = low
+	}
+	b.close = close
+}
+
+func (b *bar) addTrade(t Trade) {
+	b.addPrices(t.Price, t.Price, t.Price, t.Price)
+	quote := t.Price.Mul(t.Quantity)
+	b.volume = b.volume.Add(t.Quantity)
+	b.quoteVolume = b.quoteVolume.Add(quote)
+	if !t.IsBuyerMaker {
+		b.takerBase = b.takerBase.Add(t.Quantity)
+		b.takerQuote = b.takerQuote.Add(quote)
+	}
+	count := t.Count
+	if count <= 0 {
+		count = 1
+	}
+	b.trades += count
+}
+
+func (b *bar) merge(o *bar) *bar {
+	res := *b
+	if o.empty {
+		return &res
+	}
+	res.addPrices(o.open, o.high, o.low, o.close)
+	res.volume = res.volume.Add(o.volume)
+	res.quoteVolume = res.quoteVolume.Add(o.quoteVolume)
+	res.takerBase = res.takerBase.Add(o.takerBase)
+	res.takerQuote = res.takerQuote.Add(o.takerQuote)
+	res.trades += o.trades
+	return &res
+}
+
+func (b *bar) candle() *Candle {
+	return &Candle{
+		OpenTime:                 b.openTime,
+		Open:                     b.open.String(),
+		High:                     b.high.String(),
+		Low:                      b.low.String(),
+		Close:                    b.close.String(),
+		Volume:                   b.volume.String(),
+		CloseTime:                b.closeTime,
+		QuoteAssetVolume:         b.quoteVolume.String(),
+		TradeNum:                 b.trades,
+		TakerBuyBaseAssetVolume:  b.takerBase.String(),
+		TakerBuyQuoteAssetVolume: b.takerQuote.String(),
+	}
+}
+
+func parseCandle(c *Candle) (*bar, error) {
+	b := newBar(c.OpenTime, c.CloseTime)
+	var prices [4]common.Decimal
+	for i, s := range []string{c.Open, c.High, c.Low, c.Close} {
+		d, err := common.ParseDecimal(s)
+		if err != nil {
+			return nil, fmt.Errorf("candle: invalid price %q: %w", s, err)
+		}
+		prices[i] = d
+	}
+	b.addPrices(prices[0], prices[1], prices[2], prices[3])
+	b.volume = common.DecimalOrZero(c.Volume)
+	b.quoteVolume = common.DecimalOrZero(c.QuoteAssetVolume)
+	b.takerBase = common.DecimalOrZero(c.TakerBuyBaseAssetVolume)
+	b.takerQuote = common.DecimalOrZero(c.TakerBuyQuoteAssetVolume)
+	b.trades = c.TradeNum
+	return b, nil
+}
+
+// Builder build bars from trades or klines and hand them to its handler: the
+// bar in progress after each update, then the completed bar once.
+//
+// Time bars are aligned to the Unix epoch and close when a trade or kline of a
+// later bar is added, or when Advance passes their close time; no bar is
+// emitted for an interval without trades. Volume, tick and dollar bars open at
+// their first trade and close at the trade reaching the threshold, which is not
+// split across bars.
+type Builder struct {
+	barType   BarType
+	interval  int64
+	threshold common.Decimal
+	handler   Handler
+
+	mu sync.Mutex
+	// cur is the bar in progress, for merged klines it holds the completed
+	// klines and last the kline in progress
+	cur  *bar
+	last *bar
+}
+
+// NewTimeBuilder init a builder of bars of interval, e.g. 2m, 10m or 45m, the
+// interval must be at least 1ms
+func NewTimeBuilder(interval time.Duration, handler Handler) (*Builder, error) {
+	if interval < time.Millisecond {
+		return nil, fmt.Errorf("candle: invalid interval %s", interval)
+	}
+	return &Builder{barType: BarTypeTime, interval: interval.Milliseconds(), handler: handler}, nil
+}
+
+// NewVolumeBuilder init a builder of bars of at least volume in base asset
+func NewVolumeBuilder(volume common.Decimal, handler Handler) *Builder {
+	return &Builder{barType: BarTypeVolume, threshold: volume, handler: handler}
+}
+
+// NewTickBuilder init a builder of bars of at least trades trades
+func NewTickBuilder(trades int64, handler Handler) *Builder {
+	return &Builder{barType: BarTypeTick, threshold: common.NewDecimal(trades, 0), handler: handler}
+}
+
+// NewDollarBuilder init a builder of bars of at least quoteVolume in quote asset
+func NewDollarBuilder(quoteVolume common.Decimal, handler Handler) *Builder {
+	return &Builder{barType: BarTypeDollar, threshold: quoteVolume, handler: handler}
+}
+
+// Type return the bar type of the builder
+func (b *Builder) Type() BarType {
+	return b.barType
+}
+
+// AddTrade add a trade, trades older than the bar in progress are ignored
+func (b *Builder) AddTrade(t Trade) {
+	b.mu.Lock()
+	var emits []emit
+	if b.barType == BarTypeTime {
+		openTime := t.Time - t.Time%b.interval
+		if b.cur != nil && openTime < b.cur.openTime {
+			b.mu.Unlock()
+			return
+		}
+		emits = b.rollTo(openTime)
+		b.cur.addTrade(t)
+	} else {
+		if b.cur == nil {
+			b.cur = newBar(t.Time, t.Time)
+		}
+		b.cur.addTrade(t)
+		b.cur.closeTime = t.Time
+		if b.reached() {
+			emits = append(emits, emit{b.cur.candle(), true})
+			b.cur = nil
+		}
+	}
+	if b.cur != nil {
+		emits = append(emits, emit{b.current().candle(), false})
+	}
+	b.mu.Unlock()
+	b.notify(emits)
+}
+
+// AddCandle merge a kline of a shorter interval into the time bars, final tells
+// if the kline is completed. A kline in progress is replaced by the next update
+// of the same kline.
+func (b *Builder) AddCandle(c *Candle, final bool) error {
+	if b.barType != BarTypeTime {
+		return ErrNotTimeBar
+	}
+	k, err := parseCandle(c)
+	if err != nil {
+		return err
+	}
+	b.mu.Lock()
+	openTime := c.OpenTime - c.OpenTime%b.interval
+	if b.cur != nil && openTime < b.cur.openTime {
+		b.mu.Unlock()
+		return nil
+	}
+	emits := b.rollTo(openTime)
+	if b.last != nil && b.last.openTime != k.openTime {
+		b.cur = b.cur.merge(b.last)
+	}
+	b.last = nil
+	if final {
+		b.cur = b.cur.merge(k)
+	} else {
+		b.last = k
+	}
+	if final && c.CloseTime >= b.cur.closeTime {
+		emits = append(emits, emit{b.cur.candle(), true})
+		b.cur = nil
+	} else {
+		emits = append(emits, emit{b.current().candle(), false})
+	}
+	b.mu.Unlock()
+	b.notify(emits)
+	return nil
+}
+
+// Advance close the time bar in progress if now, in milliseconds, is past its
+// close time, e.g. from a ticker when the stream is quiet
+func (b *Builder) Advance(now int64) {
+	b.mu.Lock()
+	var emits []emit
+	if b.barType == BarTypeTime && b.cur != nil && now > b.cur.closeTime {
+		emits = b.closeCurrent()
+	}
+	b.mu.Unlock()
+	b.notify(emits)
+}
+
+// Flush close the bar in progress whatever its time or size, e.g. at the end
+// of a backfill
+func (b *Builder) Flush() {
+	b.mu.Lock()
+	emits := b.closeCurrent()
+	b.mu.Unlock()
+	b.notify(emits)
+}
+
+// Current return the bar in progress
+func (b *Builder) Current() (*Candle, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.cur == nil {
+		return nil, false
+	}
+	return b.current().candle(), true
+}
+
+type emit struct {
+	candle *Candle
+	closed bool
+}
+
+func (b *Builder) notify(emits []emit) {
+	if b.handler == nil {
+		return
+	}
+	for _, e := range emits {
+		b.handler(e.candle, e.closed)
+	}
+}
+
+// current return the bar in progress including the kline in progress
+func (b *Builder) current() *bar {
+	if b.last == nil {
+		return b.cur
+	}
+	return b.cur.merge(b.last)
+}
+
+// rollTo close the bar in progress if it opened before openTime, and start the bar of openTime
+func (b *Builder) rollTo(openTime int64) []emit {
+	var emits []emit
+	if b.cur != nil && b.cur.openTime != openTime {
+		emits = b.closeCurrent()
+	}
+	if b.cur == nil {
+		b.cur = newBar(openTime, openTime+b.interval-1)
+	}
+	return emits
+}
+
+func (b *Builder) closeCurrent() []emit {
+	if b.cur == nil {
+		return nil
+	}
+	cur := b.current()
+	b.cur, b.last = nil, nil
+	if cur.empty {
+		return nil
+	}
+	return []emit{{cur.candle(), true}}
+}
+
+func (b *Builder) reached() bool {
+	switch b.barType {
+	case BarTypeVolume:
+		return b.cur.volume.Cmp(b.threshold) >= 0
+	case BarTypeTick:
+		return common.NewDecimal(b.cur.trades, 0).Cmp(b.threshold) >= 0
+	case BarTypeDollar:
+		return b.cur.quoteVolume.Cmp(b.threshold) >= 0
+	}
+	return false
+}
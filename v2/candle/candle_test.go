@@ -0,0 +1,195 @@
+package candle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+type emitted struct {
+	candle *Candle
+	closed bool
+}
+
+type candleTestSuite struct {
+	suite.Suite
+	emitted []emitted
+}
+
+func TestCandle(t *testing.T) {
+	suite.Run(t, new(candleTestSuite))
+}
+
+func (s *candleTestSuite) SetupTest() {
+	s.emitted = nil
+}
+
+func (s *candleTestSuite) handler(candle *Candle, closed bool) {
+	s.emitted = append(s.emitted, emitted{candle, closed})
+}
+
+func (s *candleTestSuite) closed() []*Candle {
+	var res []*Candle
+	for _, e := range s.emitted {
+		if e.closed {
+			res = append(res, e.candle)
+		}
+	}
+	return res
+}
+
+func trade(time int64, price, quantity string, isBuyerMaker bool) Trade {
+	return Trade{
+		Time:         time,
+		Price:        common.MustParseDecimal(price),
+		Quantity:     common.MustParseDecimal(quantity),
+		Count:        1,
+		IsBuyerMaker: isBuyerMaker,
+	}
+}
+
+func (s *candleTestSuite) TestTimeBars() {
+	b, err := NewTimeBuilder(2*time.Minute, s.handler)
+	s.Require().NoError(err)
+	b.AddTrade(trade(1000, "10.0", "1", false))
+	b.AddTrade(trade(60000, "12.0", "2", true))
+	b.AddTrade(trade(90000, "9.0", "1", false))
+	s.Len(s.emitted, 3)
+	s.False(s.emitted[2].closed)
+	s.Equal("9.0", s.emitted[2].candle.Close)
+
+	b.AddTrade(trade(50000, "100.0", "1", false))
+	s.Len(s.emitted, 4)
+
+	b.AddTrade(trade(130000, "11.0", "1", false))
+	s.Equal([]*Candle{{
+		OpenTime:                 0,
+		Open:                     "10.0",
+		High:                     "100.0",
+		Low:                      "9.0",
+		Close:                    "100.0",
+		Volume:                   "5",
+		CloseTime:                119999,
+		QuoteAssetVolume:         "143.0",
+		TradeNum:                 4,
+		TakerBuyBaseAssetVolume:  "3",
+		TakerBuyQuoteAssetVolume: "119.0",
+	}}, s.closed())
+
+	b.AddTrade(trade(10000, "1.0", "1", false))
+	current, ok := b.Current()
+	s.True(ok)
+	s.Equal(int64(120000), current.OpenTime)
+	s.Equal("11.0", current.Close)
+
+	b.Advance(200000)
+	s.Len(s.closed(), 1)
+	b.Advance(240000)
+	s.Len(s.closed(), 2)
+	_, ok = b.Current()
+	s.False(ok)
+}
+
+func (s *candleTestSuite) TestVolumeBars() {
+	b := NewVolumeBuilder(common.MustParseDecimal("3"), s.handler)
+	b.AddTrade(trade(1000, "10", "1", false))
+	b.AddTrade(trade(2000, "11", "2.5", false))
+	b.AddTrade(trade(3000, "12", "1", false))
+	b.Flush()
+	closed := s.closed()
+	s.Len(closed, 2)
+	s.Equal(int64(1000), closed[0].OpenTime)
+	s.Equal(int64(2000), closed[0].CloseTime)
+	s.Equal("3.5", closed[0].Volume)
+	s.Equal("12", closed[1].Open)
+
+	s.Equal(ErrNotTimeBar, b.AddCandle(&Candle{}, true))
+}
+
+func (s *candleTestSuite) TestTickAndDollarBars() {
+	b := NewTickBuilder(3, s.handler)
+	agg, err := SpotAggTrade(&binance.WsAggTradeEvent{
+		Price: "10", Quantity: "1", FirstBreakdownTradeID: 1, LastBreakdownTradeID: 2, TradeTime: 1000,
+	})
+	s.NoError(err)
+	b.AddTrade(agg)
+	s.Empty(s.closed())
+	b.AddTrade(trade(2000, "11", "1", false))
+	s.Len(s.closed(), 1)
+	s.Equal(int64(3), s.closed()[0].TradeNum)
+
+	s.SetupTest()
+	b = NewDollarBuilder(common.MustParseDecimal("100"), s.handler)
+	b.AddTrade(trade(1000, "40", "1", false))
+	b.AddTrade(trade(2000, "40", "1", true))
+	s.Empty(s.closed())
+	b.AddTrade(trade(3000, "40", "1", false))
+	s.Len(s.closed(), 1)
+	s.Equal("120", s.closed()[0].QuoteAssetVolume)
+	s.Equal("80", s.closed()[0].TakerBuyQuoteAssetVolume)
+}
+
+func (s *candleTestSuite) TestInvalidInterval() {
+	for _, interval := range []time.Duration{0, -time.Minute, time.Microsecond} {
+		b, err := NewTimeBuilder(interval, s.handler)
+		s.Error(err)
+		s.Nil(b)
+	}
+}
+
+func (s *candleTestSuite) TestMergeKlines() {
+	b, err := NewTimeBuilder(2*time.Minute, s.handler)
+	s.Require().NoError(err)
+	klines := SpotKlines([]*binance.Kline{
+		{OpenTime: 0, Open: "10", High: "12", Low: "9", Close: "11", Volume: "5", CloseTime: 59999,
+			QuoteAssetVolume: "50", TradeNum: 3, TakerBuyBaseAssetVolume: "2", TakerBuyQuoteAssetVolume: "20"},
+		{OpenTime: 60000, Open: "11", High: "15", Low: "10", Close: "14", Volume: "1", CloseTime: 119999,
+			QuoteAssetVolume: "14", TradeNum: 1, TakerBuyBaseAssetVolume: "1", TakerBuyQuoteAssetVolume: "14"},
+	})
+	s.NoError(b.AddCandle(klines[0], true))
+	s.NoError(b.AddCandle(klines[1], true))
+	s.Equal([]*Candle{{
+		OpenTime:                 0,
+		Open:                     "10",
+		High:                     "15",
+		Low:                      "9",
+		Close:                    "14",
+		Volume:                   "6",
+		CloseTime:                119999,
+		QuoteAssetVolume:         "64",
+		TradeNum:                 4,
+		TakerBuyBaseAssetVolume:  "3",
+		TakerBuyQuoteAssetVolume: "34",
+	}}, s.closed())
+	s.Equal("15", (*binance.Kline)(s.closed()[0]).High)
+
+	// updates of a kline in progress replace each other
+	for _, k := range []*futures.WsKline{
+		{StartTime: 120000, EndTime: 179999, Open: "14", High: "14", Low: "14", Close: "14", Volume: "1", TradeNum: 1},
+		{StartTime: 120000, EndTime: 179999, Open: "14", High: "16", Low: "14", Close: "16", Volume: "2", TradeNum: 2},
+		{StartTime: 180000, EndTime: 239999, Open: "16", High: "17", Low: "16", Close: "17", Volume: "1", TradeNum: 1},
+	} {
+		c, final := FuturesWsKline(k)
+		s.NoError(b.AddCandle(c, final))
+	}
+	current, ok := b.Current()
+	s.True(ok)
+	s.Equal("3", current.Volume)
+	s.Equal(int64(3), current.TradeNum)
+	s.Equal("17", current.High)
+	s.Len(s.closed(), 1)
+
+	c, _ := FuturesWsKline(&futures.WsKline{StartTime: 180000, EndTime: 239999, Open: "16", High: "18",
+		Low: "16", Close: "18", Volume: "2", TradeNum: 2})
+	s.NoError(b.AddCandle(c, true))
+	s.Len(s.closed(), 2)
+	s.Equal("4", s.closed()[1].Volume)
+	s.Equal("18", s.closed()[1].Close)
+
+	s.Error(b.AddCandle(&Candle{OpenTime: 240000, Open: "x"}, true))
+}
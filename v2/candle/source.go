@@ -0,0 +1,88 @@
+package candle
+
+import (
+	"fmt"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+func newTrade(time int64, price, quantity string, count int64, isBuyerMaker bool) (Trade, error) {
+	p, err := common.ParseDecimal(price)
+	if err != nil {
+		return Trade{}, fmt.Errorf("candle: invalid price %q: %w", price, err)
+	}
+	q, err := common.ParseDecimal(quantity)
+	if err != nil {
+		return Trade{}, fmt.Errorf("candle: invalid quantity %q: %w", quantity, err)
+	}
+	return Trade{Time: time, Price: p, Quantity: q, Count: count, IsBuyerMaker: isBuyerMaker}, nil
+}
+
+// SpotAggTrade convert a spot aggregate trade event
+func SpotAggTrade(e *binance.WsAggTradeEvent) (Trade, error) {
+	return newTrade(e.TradeTime, e.Price, e.Quantity, e.LastBreakdownTradeID-e.FirstBreakdownTradeID+1, e.IsBuyerMaker)
+}
+
+// SpotTrade convert a spot trade event
+func SpotTrade(e *binance.WsTradeEvent) (Trade, error) {
+	return newTrade(e.TradeTime, e.Price, e.Quantity, 1, e.IsBuyerMaker)
+}
+
+// FuturesAggTrade convert a USD-M futures aggregate trade event
+func FuturesAggTrade(e *futures.WsAggTradeEvent) (Trade, error) {
+	return newTrade(e.TradeTime, e.Price, e.Quantity, e.LastTradeID-e.FirstTradeID+1, e.Maker)
+}
+
+// SpotWsKline convert a spot kline event, along with whether the kline is final
+func SpotWsKline(k *binance.WsKline) (*Candle, bool) {
+	return &Candle{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}, k.IsFinal
+}
+
+// FuturesWsKline convert a USD-M futures kline event, along with whether the kline is final
+func FuturesWsKline(k *futures.WsKline) (*Candle, bool) {
+	return &Candle{
+		OpenTime:                 k.StartTime,
+		Open:                     k.Open,
+		High:                     k.High,
+		Low:                      k.Low,
+		Close:                    k.Close,
+		Volume:                   k.Volume,
+		CloseTime:                k.EndTime,
+		QuoteAssetVolume:         k.QuoteVolume,
+		TradeNum:                 k.TradeNum,
+		TakerBuyBaseAssetVolume:  k.ActiveBuyVolume,
+		TakerBuyQuoteAssetVolume: k.ActiveBuyQuoteVolume,
+	}, k.IsFinal
+}
+
+// SpotKlines convert spot klines
+func SpotKlines(klines []*binance.Kline) []*Candle {
+	res := make([]*Candle, len(klines))
+	for i, k := range klines {
+		res[i] = (*Candle)(k)
+	}
+	return res
+}
+
+// FuturesKlines convert USD-M futures klines
+func FuturesKlines(klines []*futures.Kline) []*Candle {
+	res := make([]*Candle, len(klines))
+	for i, k := range klines {
+		res[i] = (*Candle)(k)
+	}
+	return res
+}
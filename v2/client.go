@@ -45,6 +45,9 @@ type SymbolStatusType string
 // SymbolFilterType define symbol filter type
 type SymbolFilterType string
 
+// SymbolChangeType define the type of a symbol change
+type SymbolChangeType string
+
 // UserDataEventType define spot user data event type
 type UserDataEventType string
 
@@ -66,6 +69,24 @@ type SideEffectType string
 // STPModeType define self trade prevention mode type
 type STPModeType string
 
+// CancelReplaceModeType define the behavior of cancel-replace when the cancel request fails
+type CancelReplaceModeType string
+
+// CancelRestrictionsType define the statuses an order must have to be canceled
+type CancelRestrictionsType string
+
+// CancelReplaceResultType define the result of each step of cancel-replace
+type CancelReplaceResultType string
+
+// ContingencyType define the type of order lists
+type ContingencyType string
+
+// ListStatusType define the status of order lists
+type ListStatusType string
+
+// ListOrderStatusType define the order status of order lists
+type ListOrderStatusType string
+
 // FuturesTransferType define futures transfer type
 type FuturesTransferType int
 
@@ -153,6 +174,7 @@ const (
 	OrderStatusTypeRejected        OrderStatusType = "REJECTED"
 	OrderStatusTypeExpired         OrderStatusType = "EXPIRED"
 	OrderStatusExpiredInMatch      OrderStatusType = "EXPIRED_IN_MATCH" // STP Expired
+	OrderStatusTypePendingNew      OrderStatusType = "PENDING_NEW"      // pending order of an OTO or OTOCO list
 
 	SymbolTypeSpot SymbolType = "SPOT"
 
@@ -175,10 +197,16 @@ const (
 	SymbolFilterTypeMaxNumAlgoOrders   SymbolFilterType = "MAX_NUM_ALGO_ORDERS"
 	SymbolFilterTypeTrailingDelta      SymbolFilterType = "TRAILING_DELTA"
 
+	SymbolChangeTypeAdded   SymbolChangeType = "ADDED"
+	SymbolChangeTypeRemoved SymbolChangeType = "REMOVED"
+	SymbolChangeTypeStatus  SymbolChangeType = "STATUS"
+	SymbolChangeTypeFilters SymbolChangeType = "FILTERS"
+
 	UserDataEventTypeOutboundAccountPosition UserDataEventType = "outboundAccountPosition"
 	UserDataEventTypeBalanceUpdate           UserDataEventType = "balanceUpdate"
 	UserDataEventTypeExecutionReport         UserDataEventType = "executionReport"
-	UserDataEventTypeListStatus              UserDataEventType = "ListStatus"
+	UserDataEventTypeListStatus              UserDataEventType = "listStatus"
+	UserDataEventTypeListenKeyExpired        UserDataEventType = "listenKeyExpired"
 
 	MarginTransferTypeToMargin MarginTransferType = 1
 	MarginTransferTypeToMain   MarginTransferType = 2
@@ -207,6 +235,28 @@ const (
 	STPModeTypeExpireMaker STPModeType = "EXPIRE_MAKER"
 	STPModeTypeExpireBoth  STPModeType = "EXPIRE_BOTH"
 
+	CancelReplaceModeTypeStopOnFailure CancelReplaceModeType = "STOP_ON_FAILURE"
+	CancelReplaceModeTypeAllowFailure  CancelReplaceModeType = "ALLOW_FAILURE"
+
+	CancelRestrictionsTypeOnlyNew             CancelRestrictionsType = "ONLY_NEW"
+	CancelRestrictionsTypeOnlyPartiallyFilled CancelRestrictionsType = "ONLY_PARTIALLY_FILLED"
+
+	CancelReplaceResultTypeSuccess      CancelReplaceResultType = "SUCCESS"
+	CancelReplaceResultTypeFailure      CancelReplaceResultType = "FAILURE"
+	CancelReplaceResultTypeNotAttempted CancelReplaceResultType = "NOT_ATTEMPTED"
+
+	ContingencyTypeOCO ContingencyType = "OCO"
+	ContingencyTypeOTO ContingencyType = "OTO"
+
+	ListStatusTypeResponse    ListStatusType = "RESPONSE"
+	ListStatusTypeExecStarted ListStatusType = "EXEC_STARTED"
+	ListStatusTypeUpdated     ListStatusType = "UPDATED"
+	ListStatusTypeAllDone     ListStatusType = "ALL_DONE"
+
+	ListOrderStatusTypeExecuting ListOrderStatusType = "EXECUTING"
+	ListOrderStatusTypeAllDone   ListOrderStatusType = "ALL_DONE"
+	ListOrderStatusTypeReject    ListOrderStatusType = "REJECT"
+
 	TransactionTypeDeposit  TransactionType = "0"
 	TransactionTypeWithdraw TransactionType = "1"
 	TransactionTypeBuy      TransactionType = "0"
@@ -388,7 +438,13 @@ type Client struct {
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
-	do         doFunc
+	// RateLimiter limits the requests before they are sent, nil disables it
+	RateLimiter *common.RateLimiter
+	// RetryPolicy sends again the requests failing with a transient error, nil disables it
+	RetryPolicy *common.RetryPolicy
+	// TimeSync replaces TimeOffset to sign requests when it is set
+	TimeSync *common.TimeSync
+	do       doFunc
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -412,7 +468,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -461,14 +517,64 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	return nil
 }
 
+// timeOffset return the offset to sign requests with
+func (c *Client) timeOffset() int64 {
+	if c.TimeSync != nil {
+		return c.TimeSync.Offset()
+	}
+	return c.TimeOffset
+}
+
+// resyncTime resync TimeSync when a signed request was rejected for its
+// timestamp, it tells if the request can be sent again
+func (c *Client) resyncTime(ctx context.Context, r *request, err error) bool {
+	if c.TimeSync == nil || r.secType != secTypeSigned || !common.IsTimestampOutOfRecvWindow(err) {
+		return false
+	}
+	_, err = c.TimeSync.Resync(ctx)
+	return err == nil
+}
+
+// callAPI send r, requests failing with a transient error are sent again
+// according to RetryPolicy when it is safe to do so, and signed requests
+// rejected for their timestamp are sent again once after resyncing TimeSync
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	resynced := false
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		var header http.Header
+		data, statusCode, header, err = c.send(ctx, r, opts...)
+		if err == nil {
+			return data, err
+		}
+		// the options were applied to r by the first attempt
+		opts = nil
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			continue
+		}
+		if c.RetryPolicy == nil || !retryable(r) {
+			return data, err
+		}
+		delay, ok := c.RetryPolicy.Delay(attempt, err, statusCode, header)
+		if !ok {
+			return data, err
+		}
+		c.debug("attempt %d failed, retry in %s: %s\n", attempt, delay, err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, err
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, r *request, opts ...RequestOption) (data []byte, statusCode int, header http.Header, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
@@ -477,13 +583,26 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
+	if c.RateLimiter != nil {
+		if cost, ok := requestCost(r); ok {
+			if err = c.RateLimiter.Wait(ctx, cost); err != nil {
+				return []byte{}, 0, nil, err
+			}
+		}
+	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
+	}
+	if c.RateLimiter != nil {
+		c.RateLimiter.Update(res.Header)
+	}
+	if r.usage != nil {
+		*r.usage = *common.ParseUsage(res.Header)
 	}
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -506,9 +625,11 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		apiErr.StatusCode = res.StatusCode
+		apiErr.RetryAfter, _ = common.ParseRetryAfter(res.Header)
+		return nil, res.StatusCode, res.Header, apiErr
 	}
-	return data, nil
+	return data, res.StatusCode, res.Header, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -552,6 +673,11 @@ func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
 }
 
+// NewKlineDownloadService init kline download service
+func (c *Client) NewKlineDownloadService() *KlineDownloadService {
+	return &KlineDownloadService{c: c}
+}
+
 func (c *Client) NewUiKlinesService() *UiKlinesService {
 	return &UiKlinesService{c: c}
 }
@@ -595,6 +721,31 @@ func (c *Client) NewCancelOCOService() *CancelOCOService {
 	return &CancelOCOService{c: c}
 }
 
+// NewCreateOrderListOCOService init creating OCO order list service
+func (c *Client) NewCreateOrderListOCOService() *CreateOrderListOCOService {
+	return &CreateOrderListOCOService{c: c}
+}
+
+// NewCreateOrderListOTOService init creating OTO order list service
+func (c *Client) NewCreateOrderListOTOService() *CreateOrderListOTOService {
+	return &CreateOrderListOTOService{c: c}
+}
+
+// NewCreateOrderListOTOCOService init creating OTOCO order list service
+func (c *Client) NewCreateOrderListOTOCOService() *CreateOrderListOTOCOService {
+	return &CreateOrderListOTOCOService{c: c}
+}
+
+// NewCreateSOROrderService init creating SOR order service
+func (c *Client) NewCreateSOROrderService() *CreateSOROrderService {
+	return &CreateSOROrderService{c: c}
+}
+
+// NewCancelReplaceService init cancel-replace order service
+func (c *Client) NewCancelReplaceService() *CancelReplaceService {
+	return &CancelReplaceService{c: c}
+}
+
 // NewGetOrderService init get order service
 func (c *Client) NewGetOrderService() *GetOrderService {
 	return &GetOrderService{c: c}
@@ -675,6 +826,16 @@ func (c *Client) NewListTradesService() *ListTradesService {
 	return &ListTradesService{c: c}
 }
 
+// NewListPreventedMatchesService init listing prevented matches service
+func (c *Client) NewListPreventedMatchesService() *ListPreventedMatchesService {
+	return &ListPreventedMatchesService{c: c}
+}
+
+// NewListAllocationsService init listing allocations service
+func (c *Client) NewListAllocationsService() *ListAllocationsService {
+	return &ListAllocationsService{c: c}
+}
+
 // NewHistoricalTradesService init listing trades service
 func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
 	return &HistoricalTradesService{c: c}
@@ -720,6 +881,16 @@ func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
 }
 
+// NewSymbolRegistry init symbol registry
+func (c *Client) NewSymbolRegistry() *SymbolRegistry {
+	return &SymbolRegistry{c: c}
+}
+
+// NewOrderValidator init order validator
+func (c *Client) NewOrderValidator() *OrderValidator {
+	return &OrderValidator{c: c}
+}
+
 // NewRateLimitService init rate limit service
 func (c *Client) NewRateLimitService() *RateLimitService {
 	return &RateLimitService{c: c}
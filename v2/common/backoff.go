@@ -0,0 +1,64 @@
+package common
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// BackoffPolicy decides how long to wait before the given attempt,
+// attempts are numbered from 1
+type BackoffPolicy interface {
+	Backoff(attempt int) time.Duration
+}
+
+// ConstantBackoff waits the same duration before every attempt
+type ConstantBackoff time.Duration
+
+// Backoff implements BackoffPolicy
+func (b ConstantBackoff) Backoff(attempt int) time.Duration {
+	return time.Duration(b)
+}
+
+// ExponentialBackoff multiplies the delay by Multiplier after every attempt,
+// starting from Initial and never exceeding Max. Jitter is the fraction of the
+// delay, between 0 and 1, that is randomized to avoid synchronized retries.
+type ExponentialBackoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+	Jitter     float64
+}
+
+// NewExponentialBackoff init an exponential backoff doubling from initial up to max with 20% jitter
+func NewExponentialBackoff(initial, max time.Duration) *ExponentialBackoff {
+	return &ExponentialBackoff{
+		Initial:    initial,
+		Max:        max,
+		Multiplier: 2,
+		Jitter:     0.2,
+	}
+}
+
+// DefaultBackoff is used when no backoff policy is configured
+var DefaultBackoff BackoffPolicy = NewExponentialBackoff(time.Second, time.Minute)
+
+// Backoff implements BackoffPolicy
+func (b *ExponentialBackoff) Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	multiplier := b.Multiplier
+	if multiplier < 1 {
+		multiplier = 1
+	}
+	delay := float64(b.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if b.Max > 0 && delay > float64(b.Max) {
+		delay = float64(b.Max)
+	}
+	if b.Jitter > 0 {
+		jitter := math.Min(b.Jitter, 1)
+		delay = delay * (1 - jitter + jitter*rand.Float64())
+	}
+	return time.Duration(delay)
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstantBackoff(t *testing.T) {
+	b := ConstantBackoff(time.Second)
+	assert.Equal(t, time.Second, b.Backoff(1))
+	assert.Equal(t, time.Second, b.Backoff(10))
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	assert := assert.New(t)
+	b := &ExponentialBackoff{
+		Initial:    100 * time.Millisecond,
+		Max:        time.Second,
+		Multiplier: 2,
+	}
+	assert.Equal(100*time.Millisecond, b.Backoff(0))
+	assert.Equal(100*time.Millisecond, b.Backoff(1))
+	assert.Equal(200*time.Millisecond, b.Backoff(2))
+	assert.Equal(400*time.Millisecond, b.Backoff(3))
+	assert.Equal(time.Second, b.Backoff(5))
+	assert.Equal(time.Second, b.Backoff(100))
+
+	b.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		d := b.Backoff(2)
+		assert.True(d >= 100*time.Millisecond && d <= 200*time.Millisecond, d)
+	}
+}
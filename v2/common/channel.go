@@ -0,0 +1,158 @@
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+const defaultChanBufferSize = 100
+
+// OverflowPolicy define what happens to an event when the channel of a stream is full
+type OverflowPolicy int
+
+// Overflow policies
+const (
+	// OverflowBlock waits for the receiver, the stream is not read meanwhile
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropOldest drops the oldest buffered event to make room for the new one
+	OverflowDropOldest
+	// OverflowDropNewest drops the new event
+	OverflowDropNewest
+)
+
+// ChanConfig define the channels returned by ServeChan
+type ChanConfig struct {
+	// BufferSize of the event and error channels, default 100
+	BufferSize int
+	Overflow   OverflowPolicy
+	// OnDrop is called with each event dropped by the overflow policy
+	OnDrop func(event interface{})
+}
+
+// ChanOption define option of the channels returned by ServeChan
+type ChanOption func(*ChanConfig)
+
+// WithBufferSize set the buffer size of the channels
+func WithBufferSize(size int) ChanOption {
+	return func(c *ChanConfig) {
+		c.BufferSize = size
+	}
+}
+
+// WithOverflow set the overflow policy of the channels
+func WithOverflow(policy OverflowPolicy) ChanOption {
+	return func(c *ChanConfig) {
+		c.Overflow = policy
+	}
+}
+
+// WithOnDrop set the handler called with the dropped events
+func WithOnDrop(f func(event interface{})) ChanOption {
+	return func(c *ChanConfig) {
+		c.OnDrop = f
+	}
+}
+
+// chanSender sends to a channel following an overflow policy
+type chanSender[T any] struct {
+	mu sync.Mutex
+	// closeMu keeps the channel open while a value is being sent
+	closeMu sync.RWMutex
+	closed  bool
+	c       chan T
+	policy  OverflowPolicy
+	onDrop  func(event interface{})
+	doneC   <-chan struct{}
+}
+
+func (s *chanSender[T]) send(v T) {
+	s.closeMu.RLock()
+	defer s.closeMu.RUnlock()
+	if s.closed {
+		return
+	}
+	switch s.policy {
+	case OverflowDropNewest:
+		select {
+		case s.c <- v:
+		default:
+			s.drop(v)
+		}
+	case OverflowDropOldest:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		for {
+			select {
+			case s.c <- v:
+				return
+			default:
+			}
+			select {
+			case old := <-s.c:
+				s.drop(old)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.c <- v:
+		case <-s.doneC:
+		}
+	}
+}
+
+// close the channel once the values being sent are sent or dropped
+func (s *chanSender[T]) close() {
+	s.closeMu.Lock()
+	defer s.closeMu.Unlock()
+	s.closed = true
+	close(s.c)
+}
+
+func (s *chanSender[T]) drop(v T) {
+	if s.onDrop != nil {
+		s.onDrop(v)
+	}
+}
+
+// ServeChan start a stream with serve and return its events and errors through
+// channels. The stream is stopped when ctx is done, and both channels are closed
+// once the stream is stopped. Errors follow the overflow policy like events.
+func ServeChan[E any](ctx context.Context, serve func(handler func(event E), errHandler func(err error)) (doneC, stopC chan struct{}, err error),
+	opts ...ChanOption) (<-chan E, <-chan error, error) {
+	cfg := &ChanConfig{BufferSize: defaultChanBufferSize}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	if cfg.BufferSize < 0 {
+		cfg.BufferSize = 0
+	}
+	if cfg.BufferSize == 0 && cfg.Overflow == OverflowDropOldest {
+		// there is no buffered event to drop
+		cfg.Overflow = OverflowDropNewest
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	// cancelC unblocks the senders once ctx is done
+	cancelC := make(chan struct{})
+	events := &chanSender[E]{c: make(chan E, cfg.BufferSize), policy: cfg.Overflow, onDrop: cfg.OnDrop, doneC: cancelC}
+	errs := &chanSender[error]{c: make(chan error, cfg.BufferSize), policy: cfg.Overflow, onDrop: cfg.OnDrop, doneC: cancelC}
+	doneC, stopC, err := serve(events.send, errs.send)
+	if err != nil {
+		return nil, nil, err
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+			close(cancelC)
+			close(stopC)
+			<-doneC
+		case <-doneC:
+			close(cancelC)
+		}
+		events.close()
+		errs.close()
+	}()
+	return events.c, errs.c, nil
+}
@@ -0,0 +1,145 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+// fakeChanStream lets the test push events to the handlers of a stream
+type fakeChanStream struct {
+	handler    func(event int)
+	errHandler func(err error)
+	doneC      chan struct{}
+	stopC      chan struct{}
+}
+
+func (f *fakeChanStream) serve(handler func(event int), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	f.handler, f.errHandler = handler, errHandler
+	f.doneC, f.stopC = make(chan struct{}), make(chan struct{})
+	go func() {
+		<-f.stopC
+		close(f.doneC)
+	}()
+	return f.doneC, f.stopC, nil
+}
+
+type channelTestSuite struct {
+	suite.Suite
+	stream *fakeChanStream
+}
+
+func TestChannel(t *testing.T) {
+	suite.Run(t, new(channelTestSuite))
+}
+
+func (s *channelTestSuite) SetupTest() {
+	s.stream = &fakeChanStream{}
+}
+
+func drain(c <-chan int) []int {
+	var res []int
+	for v := range c {
+		res = append(res, v)
+	}
+	return res
+}
+
+func (s *channelTestSuite) TestCancel() {
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs, err := ServeChan(ctx, s.stream.serve)
+	s.Require().NoError(err)
+	s.stream.handler(1)
+	s.stream.errHandler(errors.New("oops"))
+	s.stream.handler(2)
+	s.EqualError(<-errs, "oops")
+	cancel()
+	s.Equal([]int{1, 2}, drain(events))
+	_, ok := <-errs
+	s.False(ok)
+	select {
+	case <-s.stream.doneC:
+	default:
+		s.Fail("stream not stopped")
+	}
+}
+
+func (s *channelTestSuite) TestStreamDone() {
+	events, _, err := ServeChan(context.Background(), s.stream.serve)
+	s.Require().NoError(err)
+	s.stream.handler(1)
+	close(s.stream.stopC)
+	s.Equal([]int{1}, drain(events))
+}
+
+func (s *channelTestSuite) TestDropOldest() {
+	var dropped []interface{}
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _, err := ServeChan(ctx, s.stream.serve, WithBufferSize(2), WithOverflow(OverflowDropOldest),
+		WithOnDrop(func(event interface{}) { dropped = append(dropped, event) }))
+	s.Require().NoError(err)
+	for i := 1; i <= 4; i++ {
+		s.stream.handler(i)
+	}
+	cancel()
+	s.Equal([]int{3, 4}, drain(events))
+	s.Equal([]interface{}{1, 2}, dropped)
+}
+
+func (s *channelTestSuite) TestDropNewest() {
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _, err := ServeChan(ctx, s.stream.serve, WithBufferSize(2), WithOverflow(OverflowDropNewest))
+	s.Require().NoError(err)
+	for i := 1; i <= 4; i++ {
+		s.stream.handler(i)
+	}
+	cancel()
+	s.Equal([]int{1, 2}, drain(events))
+}
+
+func (s *channelTestSuite) TestBlock() {
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _, err := ServeChan(ctx, s.stream.serve, WithBufferSize(1))
+	s.Require().NoError(err)
+	s.stream.handler(1)
+	sent := make(chan struct{})
+	go func() {
+		s.stream.handler(2)
+		close(sent)
+	}()
+	select {
+	case <-sent:
+		s.FailNow("send did not block")
+	case <-time.After(20 * time.Millisecond):
+	}
+	s.Equal(1, <-events)
+	<-sent
+	s.Equal(2, <-events)
+
+	// a blocked send is released by the cancellation
+	s.stream.handler(3)
+	released := make(chan struct{})
+	go func() {
+		s.stream.handler(4)
+		close(released)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	select {
+	case <-released:
+	case <-time.After(time.Second):
+		s.FailNow("send not released")
+	}
+	s.Equal([]int{3}, drain(events))
+}
+
+func (s *channelTestSuite) TestCanceledContext() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, _, err := ServeChan(ctx, s.stream.serve)
+	s.Equal(context.Canceled, err)
+	s.Nil(s.stream.handler)
+}
@@ -0,0 +1,312 @@
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+var bigTen = big.NewInt(10)
+
+// Decimal is an exact decimal number for prices and quantities, the zero value is 0.
+// The number of digits after the point is kept, so that String returns the
+// value as it was parsed.
+type Decimal struct {
+	coef  *big.Int
+	scale int32
+}
+
+// NewDecimal return unscaled * 10^-scale, e.g. NewDecimal(123, 2) is 1.23
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	return Decimal{coef: big.NewInt(unscaled), scale: scale}
+}
+
+// NewDecimalFromFloat return the shortest decimal representing f
+func NewDecimalFromFloat(f float64) Decimal {
+	d, _ := ParseDecimal(strconv.FormatFloat(f, 'f', -1, 64))
+	return d
+}
+
+// ParseDecimal parse a decimal number such as "0.00847000", "-12", "1e-8"
+func ParseDecimal(s string) (Decimal, error) {
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i >= 0 {
+		var err error
+		exp, err = strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+		}
+		mantissa = s[:i]
+	}
+	intPart, fracPart := mantissa, ""
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		intPart, fracPart = mantissa[:i], mantissa[i+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" || digits == "-" || digits == "+" || strings.ContainsAny(digits[1:], "+-") {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	coef, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("invalid decimal %q", s)
+	}
+	scale := int64(len(fracPart)) - exp
+	if scale < 0 {
+		coef.Mul(coef, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{coef: coef, scale: int32(scale)}, nil
+}
+
+// MustParseDecimal is like ParseDecimal but panics if s is invalid
+func MustParseDecimal(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// DecimalOrZero parse s, invalid or empty strings are 0
+func DecimalOrZero(s string) Decimal {
+	d, err := ParseDecimal(s)
+	if err != nil {
+		return Decimal{}
+	}
+	return d
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
+
+func (d Decimal) c() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// rescale return the coefficient of d with scale digits after the point, scale >= d.scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	return new(big.Int).Mul(d.c(), pow10(int64(scale-d.scale)))
+}
+
+func align(a, b Decimal) (*big.Int, *big.Int, int32) {
+	scale := a.scale
+	if b.scale > scale {
+		scale = b.scale
+	}
+	return a.rescale(scale), b.rescale(scale), scale
+}
+
+// Scale return the number of digits after the point
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// Add return d + o
+func (d Decimal) Add(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return Decimal{coef: a.Add(a, b), scale: scale}
+}
+
+// Sub return d - o
+func (d Decimal) Sub(o Decimal) Decimal {
+	a, b, scale := align(d, o)
+	return Decimal{coef: a.Sub(a, b), scale: scale}
+}
+
+// Mul return d * o
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{coef: new(big.Int).Mul(d.c(), o.c()), scale: d.scale + o.scale}
+}
+
+// Div return d / o truncated toward zero to places digits after the point,
+// it panics if o is 0
+func (d Decimal) Div(o Decimal, places int32) Decimal {
+	num, den := new(big.Int).Set(d.c()), new(big.Int).Set(o.c())
+	if e := int64(places) - int64(d.scale) + int64(o.scale); e >= 0 {
+		num.Mul(num, pow10(e))
+	} else {
+		den.Mul(den, pow10(-e))
+	}
+	return Decimal{coef: num.Quo(num, den), scale: places}
+}
+
+// Neg return -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{coef: new(big.Int).Neg(d.c()), scale: d.scale}
+}
+
+// Abs return |d|
+func (d Decimal) Abs() Decimal {
+	return Decimal{coef: new(big.Int).Abs(d.c()), scale: d.scale}
+}
+
+// Sign return -1, 0 or 1 depending on the sign of d
+func (d Decimal) Sign() int {
+	return d.c().Sign()
+}
+
+// IsZero tells if d is 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp return -1, 0 or 1 if d is less than, equal to or greater than o
+func (d Decimal) Cmp(o Decimal) int {
+	a, b, _ := align(d, o)
+	return a.Cmp(b)
+}
+
+// Equal tells if d and o are the same number, whatever their scale
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+type roundingMode int
+
+const (
+	roundDown roundingMode = iota
+	roundHalfUp
+	roundFloor
+	roundCeil
+)
+
+func (d Decimal) round(places int32, mode roundingMode) Decimal {
+	if d.scale <= places {
+		return d
+	}
+	unit := pow10(int64(d.scale - places))
+	q, r := new(big.Int).QuoRem(d.c(), unit, new(big.Int))
+	if r.Sign() != 0 {
+		switch mode {
+		case roundHalfUp:
+			if new(big.Int).Abs(r).Lsh(new(big.Int).Abs(r), 1).Cmp(unit) >= 0 {
+				q.Add(q, big.NewInt(int64(d.Sign())))
+			}
+		case roundFloor:
+			if r.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+			}
+		case roundCeil:
+			if r.Sign() > 0 {
+				q.Add(q, big.NewInt(1))
+			}
+		}
+	}
+	return Decimal{coef: q, scale: places}
+}
+
+// Round round d half away from zero to places digits after the point
+func (d Decimal) Round(places int32) Decimal {
+	return d.round(places, roundHalfUp)
+}
+
+// Truncate round d toward zero to places digits after the point
+func (d Decimal) Truncate(places int32) Decimal {
+	return d.round(places, roundDown)
+}
+
+// Floor round d toward negative infinity to places digits after the point
+func (d Decimal) Floor(places int32) Decimal {
+	return d.round(places, roundFloor)
+}
+
+// Ceil round d toward positive infinity to places digits after the point
+func (d Decimal) Ceil(places int32) Decimal {
+	return d.round(places, roundCeil)
+}
+
+// Trim remove the trailing zeros after the point, e.g. 1.2300 is 1.23
+func (d Decimal) Trim() Decimal {
+	if d.IsZero() {
+		return Decimal{}
+	}
+	if d.scale <= 0 {
+		return d
+	}
+	coef, scale := new(big.Int).Set(d.coef), d.scale
+	r := new(big.Int)
+	for scale > 0 {
+		q, m := new(big.Int).QuoRem(coef, bigTen, r)
+		if m.Sign() != 0 {
+			break
+		}
+		coef, scale = q, scale-1
+	}
+	return Decimal{coef: coef, scale: scale}
+}
+
+// TruncateToStep round d toward zero to a multiple of step, e.g. the tick size
+// of a price or the step size of a quantity, with the digits after the point of step.
+// d is returned as is if step is not positive.
+func (d Decimal) TruncateToStep(step Decimal) Decimal {
+	if step.Sign() <= 0 {
+		return d
+	}
+	a, b, scale := align(d, step)
+	q := new(big.Int).Quo(a, b)
+	return Decimal{coef: q.Mul(q, b), scale: scale}.Truncate(step.scale)
+}
+
+// IsMultipleOf tells if d is a multiple of step
+func (d Decimal) IsMultipleOf(step Decimal) bool {
+	if step.IsZero() {
+		return false
+	}
+	a, b, _ := align(d, step)
+	return new(big.Int).Rem(a, b).Sign() == 0
+}
+
+// Float64 return the nearest float64 of d
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// String return d with Scale digits after the point
+func (d Decimal) String() string {
+	s := new(big.Int).Abs(d.c()).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(s); pad > 0 {
+			s = strings.Repeat("0", pad) + s
+		}
+		s = s[:len(s)-int(d.scale)] + "." + s[len(s)-int(d.scale):]
+	} else if d.scale < 0 {
+		s += strings.Repeat("0", int(-d.scale))
+	}
+	if d.Sign() < 0 {
+		s = "-" + s
+	}
+	return s
+}
+
+// MarshalJSON encode d as a string like the API does
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decode a string or a number
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	if s == "" {
+		*d = Decimal{}
+		return nil
+	}
+	v, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
@@ -0,0 +1,122 @@
+package common
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDecimal(t *testing.T) {
+	assert := assert.New(t)
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"0.00847000", "0.00847000"},
+		{"-12", "-12"},
+		{"+3.5", "3.5"},
+		{".5", "0.5"},
+		{"-0.001", "-0.001"},
+		{"1e-8", "0.00000001"},
+		{"1.5E3", "1500"},
+		{"100", "100"},
+	}
+	for _, tt := range tests {
+		d, err := ParseDecimal(tt.in)
+		assert.NoError(err, tt.in)
+		assert.Equal(tt.want, d.String(), tt.in)
+	}
+	for _, in := range []string{"", "-", ".", "1.2.3", "abc", "1-2", "1e", "--1"} {
+		_, err := ParseDecimal(in)
+		assert.Error(err, in)
+	}
+	assert.Equal("0", Decimal{}.String())
+	assert.Equal("1.23", NewDecimal(123, 2).String())
+	assert.Equal("0.1", NewDecimalFromFloat(0.1).String())
+	assert.True(DecimalOrZero("").IsZero())
+	assert.Panics(func() { MustParseDecimal("x") })
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	assert := assert.New(t)
+	a, b := MustParseDecimal("0.1"), MustParseDecimal("0.2")
+	assert.Equal("0.3", a.Add(b).String())
+	assert.True(a.Add(b).Equal(MustParseDecimal("0.30000")))
+	assert.Equal("-0.1", a.Sub(b).String())
+	assert.Equal("0.02", a.Mul(b).String())
+	assert.Equal("0.33333333", a.Div(MustParseDecimal("0.3"), 8).String())
+	assert.Equal("-2", MustParseDecimal("1").Div(MustParseDecimal("-0.5"), 0).String())
+	assert.Equal("2.5", MustParseDecimal("-2.5").Abs().String())
+	assert.Equal("-2.5", MustParseDecimal("2.5").Neg().String())
+	assert.Equal(-1, a.Cmp(b))
+	assert.Equal(1, b.Cmp(a))
+	assert.Equal(0, a.Cmp(MustParseDecimal("0.10")))
+	assert.Equal(-1, a.Neg().Sign())
+	assert.Equal(0.3, a.Add(b).Float64())
+	assert.Equal("0.1", a.String(), "operands are not modified")
+}
+
+func TestDecimalRounding(t *testing.T) {
+	assert := assert.New(t)
+	d := MustParseDecimal("1.2345")
+	assert.Equal("1.235", d.Round(3).String())
+	assert.Equal("1.234", d.Truncate(3).String())
+	assert.Equal("1.234", d.Floor(3).String())
+	assert.Equal("1.235", d.Ceil(3).String())
+	assert.Equal("1.2345", d.Round(6).String())
+	n := d.Neg()
+	assert.Equal("-1.235", n.Round(3).String())
+	assert.Equal("-1.234", n.Truncate(3).String())
+	assert.Equal("-1.235", n.Floor(3).String())
+	assert.Equal("-1.234", n.Ceil(3).String())
+	assert.Equal("-1", MustParseDecimal("-0.5").Round(0).String())
+
+	assert.Equal("1.23", MustParseDecimal("1.2300").Trim().String())
+	assert.Equal("1200", MustParseDecimal("1200.000").Trim().String())
+	assert.Equal("-0.5", MustParseDecimal("-0.50").Trim().String())
+	assert.Equal("0", MustParseDecimal("0.000").Trim().String())
+
+	step := MustParseDecimal("0.001")
+	assert.Equal("12.345", MustParseDecimal("12.34567").TruncateToStep(step).String())
+	assert.Equal("1.50", MustParseDecimal("1.74").TruncateToStep(MustParseDecimal("0.25")).String())
+	assert.Equal("1.74", MustParseDecimal("1.74").TruncateToStep(Decimal{}).String())
+	assert.True(MustParseDecimal("1.5").IsMultipleOf(MustParseDecimal("0.25")))
+	assert.False(MustParseDecimal("1.6").IsMultipleOf(MustParseDecimal("0.25")))
+	assert.False(MustParseDecimal("1.6").IsMultipleOf(Decimal{}))
+}
+
+func TestDecimalJSON(t *testing.T) {
+	assert := assert.New(t)
+	var v struct {
+		Price    Decimal  `json:"price"`
+		Quantity Decimal  `json:"qty"`
+		Empty    Decimal  `json:"empty"`
+		Null     *Decimal `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"price":"0.00847000","qty":12.5,"empty":"","null":null}`), &v)
+	assert.NoError(err)
+	assert.Equal("0.00847000", v.Price.String())
+	assert.Equal("12.5", v.Quantity.String())
+	assert.True(v.Empty.IsZero())
+	assert.Nil(v.Null)
+
+	b, err := json.Marshal(v.Price)
+	assert.NoError(err)
+	assert.Equal(`"0.00847000"`, string(b))
+
+	assert.Error(json.Unmarshal([]byte(`"x"`), &v.Price))
+}
+
+func TestPriceLevelParseDecimal(t *testing.T) {
+	assert := assert.New(t)
+	p := PriceLevel{Price: "0.10000000", Quantity: "3"}
+	price, quantity, err := p.ParseDecimal()
+	assert.NoError(err)
+	assert.Equal("0.30000000", price.Mul(quantity).String())
+	assert.Equal("0.10000000", p.PriceDecimal().String())
+	assert.Equal("3", p.QuantityDecimal().String())
+
+	_, _, err = (&PriceLevel{Price: "x"}).ParseDecimal()
+	assert.Error(err)
+}
@@ -1,7 +1,12 @@
 package common
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 )
 
 // APIError define API error when response status is 4xx or 5xx
@@ -9,6 +14,13 @@ type APIError struct {
 	Code     int64  `json:"code"`
 	Message  string `json:"msg"`
 	Response []byte `json:"-"` // Assign the body value when the Code and Message fields are invalid.
+	// Data is the detail of the error returned by some endpoints, such as the
+	// results of both steps of a failed cancel-replace
+	Data json.RawMessage `json:"data,omitempty"`
+	// StatusCode is the HTTP status of the response
+	StatusCode int `json:"-"`
+	// RetryAfter is the delay asked by the Retry-After header of 418 and 429 responses
+	RetryAfter time.Duration `json:"-"`
 }
 
 // Error return error code and message
@@ -23,8 +35,204 @@ func (e APIError) IsValid() bool {
 	return e.Code != 0 || e.Message != ""
 }
 
+// Is tells if e has the code of target, so that errors.Is(err, ErrXxx) matches
+// any API error with the code of the sentinel error ErrXxx
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code != 0 && t.Code == e.Code
+}
+
 // IsAPIError check if e is an API error
 func IsAPIError(e error) bool {
-	_, ok := e.(*APIError)
+	_, ok := AsAPIError(e)
 	return ok
 }
+
+// AsAPIError return the API error in the chain of err
+func AsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// Errors of the codes shared by the spot, futures, options and portfolio margin APIs,
+// compare them with errors.Is
+var (
+	// 10xx general server or network issues
+	ErrUnknown                 = &APIError{Code: -1000, Message: "UNKNOWN"}
+	ErrDisconnected            = &APIError{Code: -1001, Message: "DISCONNECTED"}
+	ErrUnauthorized            = &APIError{Code: -1002, Message: "UNAUTHORIZED"}
+	ErrTooManyRequests         = &APIError{Code: -1003, Message: "TOO_MANY_REQUESTS"}
+	ErrUnexpectedResponse      = &APIError{Code: -1006, Message: "UNEXPECTED_RESP"}
+	ErrTimeout                 = &APIError{Code: -1007, Message: "TIMEOUT"}
+	ErrServerBusy              = &APIError{Code: -1008, Message: "SERVER_BUSY"}
+	ErrInvalidMessage          = &APIError{Code: -1013, Message: "INVALID_MESSAGE"} // e.g. filter failures
+	ErrUnknownOrderComposition = &APIError{Code: -1014, Message: "UNKNOWN_ORDER_COMPOSITION"}
+	ErrTooManyOrders           = &APIError{Code: -1015, Message: "TOO_MANY_ORDERS"}
+	ErrServiceShuttingDown     = &APIError{Code: -1016, Message: "SERVICE_SHUTTING_DOWN"}
+	ErrUnsupportedOperation    = &APIError{Code: -1020, Message: "UNSUPPORTED_OPERATION"}
+	ErrInvalidTimestamp        = &APIError{Code: -1021, Message: "INVALID_TIMESTAMP"}
+	ErrInvalidSignature        = &APIError{Code: -1022, Message: "INVALID_SIGNATURE"}
+
+	// 11xx request issues
+	ErrIllegalChars          = &APIError{Code: -1100, Message: "ILLEGAL_CHARS"}
+	ErrTooManyParameters     = &APIError{Code: -1101, Message: "TOO_MANY_PARAMETERS"}
+	ErrMandatoryParamMissing = &APIError{Code: -1102, Message: "MANDATORY_PARAM_EMPTY_OR_MALFORMED"}
+	ErrUnknownParam          = &APIError{Code: -1103, Message: "UNKNOWN_PARAM"}
+	ErrUnreadParameters      = &APIError{Code: -1104, Message: "UNREAD_PARAMETERS"}
+	ErrParamEmpty            = &APIError{Code: -1105, Message: "PARAM_EMPTY"}
+	ErrParamNotRequired      = &APIError{Code: -1106, Message: "PARAM_NOT_REQUIRED"}
+	ErrBadPrecision          = &APIError{Code: -1111, Message: "BAD_PRECISION"}
+	ErrInvalidTimeInForce    = &APIError{Code: -1115, Message: "INVALID_TIF"}
+	ErrInvalidOrderType      = &APIError{Code: -1116, Message: "INVALID_ORDER_TYPE"}
+	ErrInvalidSide           = &APIError{Code: -1117, Message: "INVALID_SIDE"}
+	ErrBadSymbol             = &APIError{Code: -1121, Message: "BAD_SYMBOL"}
+	ErrInvalidListenKey      = &APIError{Code: -1125, Message: "INVALID_LISTEN_KEY"}
+	ErrInvalidParameter      = &APIError{Code: -1130, Message: "INVALID_PARAMETER"}
+
+	// 20xx processing issues
+	ErrNewOrderRejected             = &APIError{Code: -2010, Message: "NEW_ORDER_REJECTED"}
+	ErrCancelRejected               = &APIError{Code: -2011, Message: "CANCEL_REJECTED"}
+	ErrNoSuchOrder                  = &APIError{Code: -2013, Message: "NO_SUCH_ORDER"}
+	ErrBadAPIKeyFormat              = &APIError{Code: -2014, Message: "BAD_API_KEY_FMT"}
+	ErrRejectedAPIKey               = &APIError{Code: -2015, Message: "REJECTED_MBX_KEY"}
+	ErrNoTradingWindow              = &APIError{Code: -2016, Message: "NO_TRADING_WINDOW"}
+	ErrBalanceNotSufficient         = &APIError{Code: -2018, Message: "BALANCE_NOT_SUFFICIENT"}
+	ErrMarginNotSufficient          = &APIError{Code: -2019, Message: "MARGIN_NOT_SUFFICIENT"}
+	ErrUnableToFill                 = &APIError{Code: -2020, Message: "UNABLE_TO_FILL"}
+	ErrOrderWouldImmediatelyTrigger = &APIError{Code: -2021, Message: "ORDER_WOULD_IMMEDIATELY_TRIGGER"}
+	ErrReduceOnlyRejected           = &APIError{Code: -2022, Message: "REDUCE_ONLY_REJECT"}
+	ErrPositionNotSufficient        = &APIError{Code: -2024, Message: "POSITION_NOT_SUFFICIENT"}
+	ErrMaxOpenOrderExceeded         = &APIError{Code: -2025, Message: "MAX_OPEN_ORDER_EXCEEDED"}
+
+	// 3xxx-5xxx margin and futures issues
+	ErrMarginBalanceNotEnough   = &APIError{Code: -3041, Message: "BALANCE_IS_NOT_ENOUGH"}
+	ErrNoNeedToChangeMarginType = &APIError{Code: -4046, Message: "NO_NEED_TO_CHANGE_MARGIN_TYPE"}
+	ErrMinNotional              = &APIError{Code: -4164, Message: "MIN_NOTIONAL"}
+	ErrPostOnlyRejected         = &APIError{Code: -5022, Message: "GTX_ORDER_REJECT"}
+)
+
+// IsRateLimited tells if err was caused by exceeding a rate limit, either
+// reported by the server or by a fail fast RateLimiter
+func IsRateLimited(err error) bool {
+	var rateLimitErr RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return true
+	}
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode == http.StatusTeapot ||
+		apiErr.Code == ErrTooManyRequests.Code || apiErr.Code == ErrTooManyOrders.Code
+}
+
+// IsIPBanned tells if err is a 418 response, the IP is banned for RetryAfter
+func IsIPBanned(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	return ok && apiErr.StatusCode == http.StatusTeapot
+}
+
+// IsTimestampOutOfRecvWindow tells if the request timestamp was rejected,
+// the local clock is then out of sync with the server time
+func IsTimestampOutOfRecvWindow(err error) bool {
+	return errors.Is(err, ErrInvalidTimestamp)
+}
+
+// IsInsufficientBalance tells if an order was rejected for lack of balance or margin
+func IsInsufficientBalance(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrBalanceNotSufficient.Code, ErrMarginNotSufficient.Code, ErrMarginBalanceNotEnough.Code:
+		return true
+	case ErrNewOrderRejected.Code:
+		return strings.Contains(strings.ToLower(apiErr.Message), "insufficient balance")
+	}
+	return false
+}
+
+// IsUnknownOrder tells if the order of the request does not exist
+func IsUnknownOrder(err error) bool {
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return false
+	}
+	switch apiErr.Code {
+	case ErrNoSuchOrder.Code:
+		return true
+	case ErrCancelRejected.Code:
+		return strings.Contains(strings.ToLower(apiErr.Message), "unknown order")
+	}
+	return false
+}
+
+// IsRetryable tells if err is transient: network errors, server errors and
+// rate limits. Requests which are not idempotent may still have been executed.
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if IsRateLimited(err) {
+		return true
+	}
+	apiErr, ok := AsAPIError(err)
+	if !ok {
+		return isNetworkError(err)
+	}
+	switch apiErr.Code {
+	case ErrUnknown.Code, ErrDisconnected.Code, ErrUnexpectedResponse.Code, ErrTimeout.Code,
+		ErrServerBusy.Code, ErrServiceShuttingDown.Code:
+		return true
+	}
+	return apiErr.StatusCode >= http.StatusInternalServerError
+}
+
+// RetryAfter return the delay asked by the server before sending requests again
+func RetryAfter(err error) (time.Duration, bool) {
+	var rateLimitErr RateLimitError
+	if errors.As(err, &rateLimitErr) {
+		return rateLimitErr.RetryAfter, true
+	}
+	if apiErr, ok := AsAPIError(err); ok && apiErr.RetryAfter > 0 {
+		return apiErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// FilterError define an order breaking a filter of its symbol, found before
+// sending it. errors.Is(err, ErrInvalidMessage) matches it like the -1013
+// error the server would have returned.
+type FilterError struct {
+	Symbol string
+	// Filter is the filter type, e.g. PRICE_FILTER or LOT_SIZE
+	Filter string
+	// Field is the checked order parameter, e.g. price or quantity
+	Field  string
+	Value  string
+	Reason string
+}
+
+// Error return the filter and the reason
+func (e *FilterError) Error() string {
+	return fmt.Sprintf("<FilterError> symbol=%s, filter=%s, %s=%s %s", e.Symbol, e.Filter, e.Field, e.Value, e.Reason)
+}
+
+// Is tells if target is ErrInvalidMessage
+func (e *FilterError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code == ErrInvalidMessage.Code
+}
+
+// AsFilterError return the filter error in the chain of err
+func AsFilterError(err error) (*FilterError, bool) {
+	var filterErr *FilterError
+	if errors.As(err, &filterErr) {
+		return filterErr, true
+	}
+	return nil, false
+}
@@ -0,0 +1,98 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorIs(t *testing.T) {
+	assert := assert.New(t)
+	err := fmt.Errorf("cancel order: %w", &APIError{Code: -2011, Message: "Unknown order sent."})
+	assert.True(errors.Is(err, ErrCancelRejected))
+	assert.False(errors.Is(err, ErrNoSuchOrder))
+	assert.False(errors.Is(&APIError{Message: "no code"}, &APIError{Message: "no code"}))
+	assert.True(IsAPIError(err))
+	assert.False(IsAPIError(errors.New("dummy")))
+	apiErr, ok := AsAPIError(err)
+	assert.True(ok)
+	assert.Equal(int64(-2011), apiErr.Code)
+}
+
+func TestErrorClassification(t *testing.T) {
+	assert := assert.New(t)
+	banned := &APIError{Code: -1003, Message: "Way too many requests; IP banned.", StatusCode: http.StatusTeapot, RetryAfter: time.Minute}
+	tooMany := &APIError{Code: -1003, Message: "Too many requests.", StatusCode: http.StatusTooManyRequests}
+	timestamp := &APIError{Code: -1021, Message: "Timestamp for this request is outside of the recvWindow.", StatusCode: http.StatusBadRequest}
+	spotBalance := &APIError{Code: -2010, Message: "Account has insufficient balance for requested action.", StatusCode: http.StatusBadRequest}
+	futuresMargin := &APIError{Code: -2019, Message: "Margin is insufficient.", StatusCode: http.StatusBadRequest}
+	rejected := &APIError{Code: -2010, Message: "Order would immediately match and take.", StatusCode: http.StatusBadRequest}
+	unknownOrder := &APIError{Code: -2011, Message: "Unknown order sent.", StatusCode: http.StatusBadRequest}
+	noSuchOrder := &APIError{Code: -2013, Message: "Order does not exist.", StatusCode: http.StatusBadRequest}
+	timeout := &APIError{Code: -1007, Message: "Timeout waiting for response from backend server.", StatusCode: http.StatusRequestTimeout}
+	serverErr := &APIError{Response: []byte("<html>"), StatusCode: http.StatusBadGateway}
+	netErr := &url.Error{Op: "Get", URL: "https://api.binance.com", Err: errors.New("connection reset")}
+	limiterErr := RateLimitError{RetryAfter: time.Second}
+
+	assert.True(IsRateLimited(banned))
+	assert.True(IsRateLimited(tooMany))
+	assert.True(IsRateLimited(limiterErr))
+	assert.False(IsRateLimited(timestamp))
+	assert.False(IsRateLimited(netErr))
+
+	assert.True(IsIPBanned(banned))
+	assert.False(IsIPBanned(tooMany))
+
+	assert.True(IsTimestampOutOfRecvWindow(timestamp))
+	assert.False(IsTimestampOutOfRecvWindow(rejected))
+
+	assert.True(IsInsufficientBalance(spotBalance))
+	assert.True(IsInsufficientBalance(futuresMargin))
+	assert.False(IsInsufficientBalance(rejected))
+	assert.False(IsInsufficientBalance(netErr))
+
+	assert.True(IsUnknownOrder(unknownOrder))
+	assert.True(IsUnknownOrder(noSuchOrder))
+	assert.False(IsUnknownOrder(&APIError{Code: -2011, Message: "Order was canceled or expired."}))
+
+	for _, err := range []error{banned, tooMany, timeout, serverErr, netErr, limiterErr} {
+		assert.True(IsRetryable(err), err.Error())
+	}
+	for _, err := range []error{nil, timestamp, spotBalance, unknownOrder, errors.New("dummy")} {
+		assert.False(IsRetryable(err), fmt.Sprint(err))
+	}
+
+	d, ok := RetryAfter(banned)
+	assert.True(ok)
+	assert.Equal(time.Minute, d)
+	d, ok = RetryAfter(limiterErr)
+	assert.True(ok)
+	assert.Equal(time.Second, d)
+	_, ok = RetryAfter(tooMany)
+	assert.False(ok)
+}
+
+func TestFilterError(t *testing.T) {
+	assert := assert.New(t)
+	err := fmt.Errorf("create order: %w", &FilterError{
+		Symbol: "BTCUSDT",
+		Filter: "PRICE_FILTER",
+		Field:  "price",
+		Value:  "0.001",
+		Reason: "is less than minPrice 0.01",
+	})
+	assert.Equal("create order: <FilterError> symbol=BTCUSDT, filter=PRICE_FILTER, price=0.001 is less than minPrice 0.01", err.Error())
+	assert.True(errors.Is(err, ErrInvalidMessage))
+	assert.False(errors.Is(err, ErrBadPrecision))
+	assert.False(IsAPIError(err))
+	filterErr, ok := AsFilterError(err)
+	assert.True(ok)
+	assert.Equal("PRICE_FILTER", filterErr.Filter)
+	_, ok = AsFilterError(ErrInvalidMessage)
+	assert.False(ok)
+}
@@ -6,7 +6,8 @@ import (
 	"math"
 )
 
-// AmountToLotSize converts an amount to a lot sized amount
+// AmountToLotSize converts an amount to a lot sized amount,
+// use Decimal.TruncateToStep to avoid float rounding errors
 func AmountToLotSize(lot float64, precision int, amount float64) float64 {
 	return math.Trunc(math.Floor(amount/lot)*lot*math.Pow10(precision)) / math.Pow10(precision)
 }
@@ -0,0 +1,163 @@
+package common
+
+import (
+	"context"
+	"time"
+)
+
+// Iterator walk the items of a paginated endpoint, fetching the next page
+// when the current one is consumed:
+//
+//	it := client.NewListTradesService().Symbol("BTCUSDT").StartTime(start).Iterate()
+//	for it.Next(ctx) {
+//		trade := it.Value()
+//	}
+//	if err := it.Err(); err != nil {
+//	}
+type Iterator[T any] struct {
+	next  func(ctx context.Context) ([]T, bool, error)
+	page  []T
+	value T
+	more  bool
+	err   error
+}
+
+// NewIterator init an iterator, next return a page and whether there are more pages
+func NewIterator[T any](next func(ctx context.Context) (page []T, more bool, err error)) *Iterator[T] {
+	return &Iterator[T]{next: next, more: true}
+}
+
+// Next advance to the next item, it returns false when there is no more item
+// or an error occurred, see Err
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	for len(it.page) == 0 {
+		if !it.more || it.err != nil {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.more, it.err = it.next(ctx)
+		if it.err != nil {
+			return false
+		}
+	}
+	it.value, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+// Value return the current item
+func (it *Iterator[T]) Value() T {
+	return it.value
+}
+
+// Err return the error which stopped the iteration
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// All return the remaining items
+func (it *Iterator[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for it.Next(ctx) {
+		items = append(items, it.Value())
+	}
+	return items, it.Err()
+}
+
+// NewIDIterator walk the items from fromID, fetch return the items whose id is
+// greater or equal to fromID in ascending order. The walk stops at the first
+// page with less than limit items.
+func NewIDIterator[T any](fromID int64, limit int, fetch func(ctx context.Context, fromID int64) ([]T, error),
+	id func(T) int64) *Iterator[T] {
+	return NewIterator(func(ctx context.Context) ([]T, bool, error) {
+		page, err := fetch(ctx, fromID)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(page) < limit || len(page) == 0 {
+			return page, false, nil
+		}
+		fromID = id(page[len(page)-1]) + 1
+		return page, true, nil
+	})
+}
+
+// NewTimeIterator walk the items of [start, end] in milliseconds by windows of
+// at most window, fetch return the items of [start, end] in ascending time order.
+// When a page is full the next one starts at the time of its last item, key
+// identifies the items to skip the ones already returned at that time, if key is
+// nil the next page starts one millisecond later.
+func NewTimeIterator[T any](start, end int64, window time.Duration, limit int,
+	fetch func(ctx context.Context, start, end int64) ([]T, error), timeOf func(T) int64, key func(T) string) *Iterator[T] {
+	windowMs := window.Milliseconds()
+	cursor := start
+	seen := map[string]bool{}
+	return NewIterator(func(ctx context.Context) ([]T, bool, error) {
+		windowEnd := end
+		if windowMs > 0 && cursor+windowMs-1 < end {
+			windowEnd = cursor + windowMs - 1
+		}
+		raw, err := fetch(ctx, cursor, windowEnd)
+		if err != nil {
+			return nil, false, err
+		}
+		page := raw
+		if key != nil && len(seen) > 0 {
+			page = make([]T, 0, len(raw))
+			for _, item := range raw {
+				if timeOf(item) == cursor && seen[key(item)] {
+					continue
+				}
+				page = append(page, item)
+			}
+		}
+		if len(raw) >= limit && len(raw) > 0 {
+			last := timeOf(raw[len(raw)-1])
+			switch {
+			case key == nil || (len(page) == 0 && last == cursor):
+				cursor, seen = last+1, map[string]bool{}
+			default:
+				if last != cursor {
+					cursor, seen = last, map[string]bool{}
+				}
+				for _, item := range raw {
+					if timeOf(item) == cursor {
+						seen[key(item)] = true
+					}
+				}
+			}
+			if cursor <= windowEnd {
+				return page, true, nil
+			}
+		}
+		cursor, seen = windowEnd+1, map[string]bool{}
+		return page, cursor <= end, nil
+	})
+}
+
+// NewOffsetIterator walk the items of [start, end] in milliseconds by windows
+// of at most window, fetch return the items of [start, end] from offset. If start
+// and end are 0 the items are walked without time range.
+func NewOffsetIterator[T any](start, end int64, window time.Duration, limit int,
+	fetch func(ctx context.Context, start, end int64, offset int) ([]T, error)) *Iterator[T] {
+	windowMs := window.Milliseconds()
+	cursor, offset := start, 0
+	return NewIterator(func(ctx context.Context) ([]T, bool, error) {
+		windowEnd := end
+		if windowMs > 0 && cursor+windowMs-1 < end {
+			windowEnd = cursor + windowMs - 1
+		}
+		page, err := fetch(ctx, cursor, windowEnd, offset)
+		if err != nil {
+			return nil, false, err
+		}
+		if len(page) >= limit && len(page) > 0 {
+			offset += len(page)
+			return page, true, nil
+		}
+		cursor, offset = windowEnd+1, 0
+		return page, cursor <= end && end > 0, nil
+	})
+}
@@ -0,0 +1,141 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	ID   int64
+	Time int64
+}
+
+func TestIterator(t *testing.T) {
+	assert := assert.New(t)
+	pages := [][]int{{1, 2}, {}, {3}}
+	calls := 0
+	it := NewIterator(func(ctx context.Context) ([]int, bool, error) {
+		page := pages[calls]
+		calls++
+		return page, calls < len(pages), nil
+	})
+	items, err := it.All(context.Background())
+	assert.NoError(err)
+	assert.Equal([]int{1, 2, 3}, items)
+	assert.Equal(3, calls)
+	assert.False(it.Next(context.Background()))
+
+	fetchErr := errors.New("fetch error")
+	it = NewIterator(func(ctx context.Context) ([]int, bool, error) {
+		return nil, true, fetchErr
+	})
+	assert.False(it.Next(context.Background()))
+	assert.Equal(fetchErr, it.Err())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	it = NewIterator(func(ctx context.Context) ([]int, bool, error) {
+		return []int{1}, true, nil
+	})
+	assert.False(it.Next(ctx))
+	assert.Equal(context.Canceled, it.Err())
+}
+
+func TestIDIterator(t *testing.T) {
+	assert := assert.New(t)
+	var fromIDs []int64
+	it := NewIDIterator(5, 2, func(ctx context.Context, fromID int64) ([]item, error) {
+		fromIDs = append(fromIDs, fromID)
+		var page []item
+		for id := fromID; id < fromID+2 && id <= 9; id++ {
+			page = append(page, item{ID: id})
+		}
+		return page, nil
+	}, func(i item) int64 { return i.ID })
+	items, err := it.All(context.Background())
+	assert.NoError(err)
+	assert.Len(items, 5)
+	assert.Equal(int64(9), items[4].ID)
+	assert.Equal([]int64{5, 7, 9}, fromIDs)
+}
+
+func TestTimeIterator(t *testing.T) {
+	assert := assert.New(t)
+	data := []item{{1, 50}, {2, 100}, {3, 100}, {4, 100}, {5, 100}, {6, 150}, {7, 250}}
+	type call struct{ start, end int64 }
+	var calls []call
+	fetch := func(ctx context.Context, start, end int64) ([]item, error) {
+		calls = append(calls, call{start, end})
+		var page []item
+		for _, i := range data {
+			if i.Time >= start && i.Time <= end && len(page) < 4 {
+				page = append(page, i)
+			}
+		}
+		return page, nil
+	}
+	timeOf := func(i item) int64 { return i.Time }
+	key := func(i item) string { return strconv.FormatInt(i.ID, 10) }
+
+	it := NewTimeIterator(0, 299, 200*time.Millisecond, 4, fetch, timeOf, key)
+	items, err := it.All(context.Background())
+	assert.NoError(err)
+	var ids []int64
+	for _, i := range items {
+		ids = append(ids, i.ID)
+	}
+	assert.Equal([]int64{1, 2, 3, 4, 5, 6, 7}, ids)
+	assert.Equal([]call{{0, 199}, {100, 299}, {100, 299}, {101, 299}}, calls)
+
+	// without key the next page starts one millisecond later, item 5 is lost
+	calls = nil
+	it = NewTimeIterator(0, 299, 200*time.Millisecond, 4, fetch, timeOf, nil)
+	items, err = it.All(context.Background())
+	assert.NoError(err)
+	assert.Len(items, 6)
+	assert.Equal([]call{{0, 199}, {101, 299}}, calls)
+
+	// empty windows are skipped
+	calls = nil
+	it = NewTimeIterator(1000, 1999, 400*time.Millisecond, 4, fetch, timeOf, key)
+	items, err = it.All(context.Background())
+	assert.NoError(err)
+	assert.Empty(items)
+	assert.Equal([]call{{1000, 1399}, {1400, 1799}, {1800, 1999}}, calls)
+}
+
+func TestOffsetIterator(t *testing.T) {
+	assert := assert.New(t)
+	type call struct {
+		start, end int64
+		offset     int
+	}
+	var calls []call
+	it := NewOffsetIterator(0, 0, time.Hour, 2, func(ctx context.Context, start, end int64, offset int) ([]int, error) {
+		calls = append(calls, call{start, end, offset})
+		data := []int{1, 2, 3}[offset:]
+		if len(data) > 2 {
+			data = data[:2]
+		}
+		return data, nil
+	})
+	items, err := it.All(context.Background())
+	assert.NoError(err)
+	assert.Equal([]int{1, 2, 3}, items)
+	assert.Equal([]call{{0, 0, 0}, {0, 0, 2}}, calls)
+
+	calls = nil
+	it = NewOffsetIterator(1000, 2999, time.Second, 10, func(ctx context.Context, start, end int64, offset int) ([]int, error) {
+		calls = append(calls, call{start, end, offset})
+		return []int{int(start)}, nil
+	})
+	items, err = it.All(context.Background())
+	assert.NoError(err)
+	assert.Equal([]int{1000, 2000}, items)
+	assert.Equal([]call{{1000, 1999, 0}, {2000, 2999, 0}}, calls)
+}
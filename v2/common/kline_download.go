@@ -0,0 +1,217 @@
+package common
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// KlineCSVHeader define the columns of the klines written as CSV
+var KlineCSVHeader = []string{
+	"openTime", "open", "high", "low", "close", "volume", "closeTime",
+	"quoteAssetVolume", "tradeNum", "takerBuyBaseAssetVolume", "takerBuyQuoteAssetVolume",
+}
+
+// KlineGap define a range [Start, End) in milliseconds in which klines were
+// expected but none was returned
+type KlineGap struct {
+	Start int64 `json:"start"`
+	End   int64 `json:"end"`
+}
+
+// KlineDownloader download the klines of a time range page by page, several
+// pages at once
+type KlineDownloader[T any] struct {
+	// Interval of the klines, e.g. 1m, 4h, 1d, 1w, 1M
+	Interval string
+	// Limit of klines per page
+	Limit int
+	// MaxSpan is the longest time range of a page in milliseconds, no maximum if 0
+	MaxSpan int64
+	// Concurrency is the number of pages fetched at once, default 1
+	Concurrency int
+	// Fetch return the klines whose open time is in [start, end]
+	Fetch func(ctx context.Context, start, end int64, limit int) ([]T, error)
+	// OpenTime return the open time of a kline
+	OpenTime func(T) int64
+}
+
+// Download fetch the klines of [start, end) in milliseconds, it returns them
+// sorted by open time without duplicates, along with the gaps between them
+func (d *KlineDownloader[T]) Download(ctx context.Context, start, end int64) ([]T, []KlineGap, error) {
+	if d.Limit <= 0 {
+		return nil, nil, fmt.Errorf("invalid limit %d", d.Limit)
+	}
+	if _, err := nextKlineOpenTime(start, d.Interval); err != nil {
+		return nil, nil, err
+	}
+	var pages [][2]int64
+	for pageStart := start; pageStart < end; {
+		pageEnd := pageStart
+		for i := 0; i < d.Limit && pageEnd < end; i++ {
+			next, _ := nextKlineOpenTime(pageEnd, d.Interval)
+			if d.MaxSpan > 0 && next-pageStart > d.MaxSpan && pageEnd > pageStart {
+				break
+			}
+			pageEnd = next
+		}
+		if d.MaxSpan > 0 && pageEnd-pageStart > d.MaxSpan {
+			pageEnd = pageStart + d.MaxSpan
+		}
+		if pageEnd > end {
+			pageEnd = end
+		}
+		pages = append(pages, [2]int64{pageStart, pageEnd})
+		pageStart = pageEnd
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	concurrency := d.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	results := make([][]T, len(pages))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	for i, page := range pages {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func(i int, start, end int64) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			klines, err := d.Fetch(ctx, start, end-1, d.Limit)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = klines
+		}(i, page[0], page[1])
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	var klines []T
+	for _, page := range results {
+		for _, k := range page {
+			if t := d.OpenTime(k); t >= start && t < end {
+				klines = append(klines, k)
+			}
+		}
+	}
+	sort.SliceStable(klines, func(i, j int) bool { return d.OpenTime(klines[i]) < d.OpenTime(klines[j]) })
+	unique := klines[:0]
+	for i, k := range klines {
+		if i > 0 && d.OpenTime(k) == d.OpenTime(klines[i-1]) {
+			continue
+		}
+		unique = append(unique, k)
+	}
+	return unique, d.gaps(unique, start, end), nil
+}
+
+// gaps find the ranges of [start, end) where a kline should have opened, the
+// klines opening after now are not expected
+func (d *KlineDownloader[T]) gaps(klines []T, start, end int64) []KlineGap {
+	if now := time.Now().UnixMilli() + 1; end > now {
+		end = now
+	}
+	var gaps []KlineGap
+	expected := start
+	for i, k := range klines {
+		t := d.OpenTime(k)
+		// klines are aligned to the interval, start may not be
+		if (i > 0 && t > expected) || (i == 0 && t-expected >= d.duration(t)) {
+			gaps = append(gaps, KlineGap{Start: expected, End: t})
+		}
+		expected, _ = nextKlineOpenTime(t, d.Interval)
+	}
+	if expected < end && (len(klines) > 0 || end-start >= d.duration(start)) {
+		gaps = append(gaps, KlineGap{Start: expected, End: end})
+	}
+	return gaps
+}
+
+func (d *KlineDownloader[T]) duration(t int64) int64 {
+	next, _ := nextKlineOpenTime(t, d.Interval)
+	return next - t
+}
+
+// nextKlineOpenTime return the open time of the kline after the one opening at t
+func nextKlineOpenTime(t int64, interval string) (int64, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("invalid interval %q", interval)
+	}
+	n, err := strconv.Atoi(interval[:len(interval)-1])
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid interval %q", interval)
+	}
+	var unit time.Duration
+	switch interval[len(interval)-1] {
+	case 's':
+		unit = time.Second
+	case 'm':
+		unit = time.Minute
+	case 'h':
+		unit = time.Hour
+	case 'd':
+		unit = 24 * time.Hour
+	case 'w':
+		unit = 7 * 24 * time.Hour
+	case 'M':
+		return time.UnixMilli(t).UTC().AddDate(0, n, 0).UnixMilli(), nil
+	default:
+		return 0, fmt.Errorf("invalid interval %q", interval)
+	}
+	return t + (time.Duration(n) * unit).Milliseconds(), nil
+}
+
+// WriteCSV write the header then a record per item
+func WriteCSV[T any](w io.Writer, header []string, items []T, record func(T) []string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := cw.Write(record(item)); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteNDJSON write the items as JSON, one per line
+func WriteNDJSON[T any](w io.Writer, items []T) error {
+	enc := json.NewEncoder(w)
+	for _, item := range items {
+		if err := enc.Encode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testKline struct {
+	OpenTime int64 `json:"openTime"`
+}
+
+func TestKlineDownloader(t *testing.T) {
+	assert := assert.New(t)
+	const minute = int64(60000)
+	missing := map[int64]bool{4 * minute: true, 5 * minute: true}
+	var mu sync.Mutex
+	var ranges [][2]int64
+	d := &KlineDownloader[*testKline]{
+		Interval:    "1m",
+		Limit:       3,
+		Concurrency: 2,
+		Fetch: func(ctx context.Context, start, end int64, limit int) ([]*testKline, error) {
+			mu.Lock()
+			ranges = append(ranges, [2]int64{start, end})
+			mu.Unlock()
+			var klines []*testKline
+			// overlap the previous page by one kline
+			for t := start - start%minute - minute; t <= end && len(klines) <= limit; t += minute {
+				if t >= 0 && !missing[t] {
+					klines = append(klines, &testKline{OpenTime: t})
+				}
+			}
+			return klines, nil
+		},
+		OpenTime: func(k *testKline) int64 { return k.OpenTime },
+	}
+	klines, gaps, err := d.Download(context.Background(), 0, 10*minute)
+	assert.NoError(err)
+	var openTimes []int64
+	for _, k := range klines {
+		openTimes = append(openTimes, k.OpenTime/minute)
+	}
+	assert.Equal([]int64{0, 1, 2, 3, 6, 7, 8, 9}, openTimes)
+	assert.Equal([]KlineGap{{Start: 4 * minute, End: 6 * minute}}, gaps)
+	assert.ElementsMatch([][2]int64{
+		{0, 3*minute - 1}, {3 * minute, 6*minute - 1}, {6 * minute, 9*minute - 1}, {9 * minute, 10*minute - 1},
+	}, ranges)
+
+	missing = map[int64]bool{0: true, 9 * minute: true}
+	_, gaps, err = d.Download(context.Background(), 0, 10*minute)
+	assert.NoError(err)
+	assert.Equal([]KlineGap{{Start: 0, End: minute}, {Start: 9 * minute, End: 10 * minute}}, gaps)
+
+	fetchErr := errors.New("fetch error")
+	d.Fetch = func(ctx context.Context, start, end int64, limit int) ([]*testKline, error) {
+		return nil, fetchErr
+	}
+	_, _, err = d.Download(context.Background(), 0, 10*minute)
+	assert.Equal(fetchErr, err)
+
+	ranges = nil
+	d.Limit = 10
+	d.MaxSpan = 4 * minute
+	d.Fetch = func(ctx context.Context, start, end int64, limit int) ([]*testKline, error) {
+		mu.Lock()
+		ranges = append(ranges, [2]int64{start, end})
+		mu.Unlock()
+		return nil, nil
+	}
+	_, _, err = d.Download(context.Background(), 0, 10*minute)
+	assert.NoError(err)
+	assert.ElementsMatch([][2]int64{
+		{0, 4*minute - 1}, {4 * minute, 8*minute - 1}, {8 * minute, 10*minute - 1},
+	}, ranges)
+
+	d.Interval = "1x"
+	_, _, err = d.Download(context.Background(), 0, 10*minute)
+	assert.Error(err)
+}
+
+func TestNextKlineOpenTime(t *testing.T) {
+	assert := assert.New(t)
+	for interval, next := range map[string]int64{
+		"1s": 1000, "15m": 900000, "4h": 14400000, "3d": 259200000, "1w": 604800000,
+		"1M": 31 * 86400000,
+	} {
+		got, err := nextKlineOpenTime(0, interval)
+		assert.NoError(err, interval)
+		assert.Equal(next, got, interval)
+	}
+	for _, interval := range []string{"", "m", "0m", "1y"} {
+		_, err := nextKlineOpenTime(0, interval)
+		assert.Error(err, interval)
+	}
+}
+
+func TestWriteKlines(t *testing.T) {
+	assert := assert.New(t)
+	klines := []*testKline{{OpenTime: 1}, {OpenTime: 2}}
+	var buf bytes.Buffer
+	err := WriteCSV(&buf, []string{"openTime"}, klines, func(k *testKline) []string {
+		return []string{strconv.FormatInt(k.OpenTime, 10)}
+	})
+	assert.NoError(err)
+	assert.Equal("openTime\n1\n2\n", buf.String())
+
+	buf.Reset()
+	assert.NoError(WriteNDJSON(&buf, klines))
+	assert.Equal("{\"openTime\":1}\n{\"openTime\":2}\n", buf.String())
+}
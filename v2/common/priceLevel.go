@@ -24,6 +24,30 @@ func (p *PriceLevel) Parse() (float64, float64, error) {
 	return price, quantity, nil
 }
 
+// ParseDecimal parses this PriceLevel's Price and Quantity
+// as exact decimals.
+func (p *PriceLevel) ParseDecimal() (Decimal, Decimal, error) {
+	price, err := ParseDecimal(p.Price)
+	if err != nil {
+		return Decimal{}, Decimal{}, err
+	}
+	quantity, err := ParseDecimal(p.Quantity)
+	if err != nil {
+		return price, Decimal{}, err
+	}
+	return price, quantity, nil
+}
+
+// PriceDecimal return Price as a decimal, 0 if invalid
+func (p PriceLevel) PriceDecimal() Decimal {
+	return DecimalOrZero(p.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal, 0 if invalid
+func (p PriceLevel) QuantityDecimal() Decimal {
+	return DecimalOrZero(p.Quantity)
+}
+
 func JsonToLevels(j [][]string) []PriceLevel {
 	levels := make([]PriceLevel, len(j))
 	for i, v := range j {
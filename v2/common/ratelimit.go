@@ -0,0 +1,276 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Rate limit types tracked by RateLimiter
+const (
+	RateLimitTypeRequestWeight = "REQUEST_WEIGHT"
+	RateLimitTypeOrders        = "ORDERS"
+	RateLimitTypeRawRequests   = "RAW_REQUESTS"
+)
+
+const (
+	usedWeightHeaderPrefix = "X-MBX-USED-WEIGHT-"
+	orderCountHeaderPrefix = "X-MBX-ORDER-COUNT-"
+)
+
+// RateLimitRule define a limit of Type counted over a fixed window of Interval
+type RateLimitRule struct {
+	Type     string
+	Interval time.Duration
+	Limit    int64
+}
+
+// NewRateLimitRule init a rule from the fields of an exchangeInfo rate limit,
+// interval is one of SECOND, MINUTE, HOUR or DAY
+func NewRateLimitRule(rateLimitType string, interval string, intervalNum int64, limit int64) (RateLimitRule, error) {
+	var unit time.Duration
+	switch interval {
+	case "SECOND":
+		unit = time.Second
+	case "MINUTE":
+		unit = time.Minute
+	case "HOUR":
+		unit = time.Hour
+	case "DAY":
+		unit = 24 * time.Hour
+	default:
+		return RateLimitRule{}, fmt.Errorf("unknown rate limit interval %q", interval)
+	}
+	if intervalNum <= 0 {
+		intervalNum = 1
+	}
+	return RateLimitRule{Type: rateLimitType, Interval: time.Duration(intervalNum) * unit, Limit: limit}, nil
+}
+
+// RequestCost define what a request counts against the limits,
+// each request also counts once against RAW_REQUESTS
+type RequestCost struct {
+	Weight int64
+	Orders int64
+}
+
+// RateLimitError is returned by a fail fast RateLimiter when a request would exceed a limit
+type RateLimitError struct {
+	Rule       RateLimitRule
+	RetryAfter time.Duration
+}
+
+// Error return error message
+func (e RateLimitError) Error() string {
+	return fmt.Sprintf("<RateLimitError> %s limit %d per %s would be exceeded, retry after %s",
+		e.Rule.Type, e.Rule.Limit, e.Rule.Interval, e.RetryAfter)
+}
+
+type rateLimitWindow struct {
+	rule  RateLimitRule
+	start time.Time
+	used  int64
+}
+
+func (w *rateLimitWindow) roll(now time.Time) {
+	if start := now.Truncate(w.rule.Interval); start.After(w.start) {
+		w.start = start
+		w.used = 0
+	}
+}
+
+func (w *rateLimitWindow) amount(cost RequestCost) int64 {
+	switch w.rule.Type {
+	case RateLimitTypeRequestWeight:
+		return cost.Weight
+	case RateLimitTypeOrders:
+		return cost.Orders
+	case RateLimitTypeRawRequests:
+		return 1
+	}
+	return 0
+}
+
+// RateLimiter counts requests against fixed windows aligned like the server ones,
+// it blocks (or fails fast) before a request would exceed a limit and takes
+// the usage reported by the response headers into account
+type RateLimiter struct {
+	// FailFast return a RateLimitError instead of waiting for the window to reset
+	FailFast bool
+
+	mu      sync.Mutex
+	windows []*rateLimitWindow
+	now     func() time.Time
+}
+
+// NewRateLimiter init a rate limiter enforcing rules
+func NewRateLimiter(rules ...RateLimitRule) *RateLimiter {
+	l := &RateLimiter{now: time.Now}
+	l.SetRules(rules...)
+	return l
+}
+
+// SetRules replace the enforced rules, the usage of the rules kept is not reset
+func (l *RateLimiter) SetRules(rules ...RateLimitRule) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	windows := make([]*rateLimitWindow, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Interval <= 0 {
+			continue
+		}
+		w := &rateLimitWindow{rule: rule}
+		for _, old := range l.windows {
+			if old.rule.Type == rule.Type && old.rule.Interval == rule.Interval {
+				w.start, w.used = old.start, old.used
+			}
+		}
+		windows = append(windows, w)
+	}
+	l.windows = windows
+}
+
+// Rules return the enforced rules
+func (l *RateLimiter) Rules() []RateLimitRule {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	rules := make([]RateLimitRule, len(l.windows))
+	for i, w := range l.windows {
+		rules[i] = w.rule
+	}
+	return rules
+}
+
+// Used return the usage counted in the current window of the rule of rateLimitType and interval
+func (l *RateLimiter) Used(rateLimitType string, interval time.Duration) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if w := l.window(rateLimitType, interval); w != nil {
+		w.roll(l.now())
+		return w.used
+	}
+	return 0
+}
+
+func (l *RateLimiter) window(rateLimitType string, interval time.Duration) *rateLimitWindow {
+	for _, w := range l.windows {
+		if w.rule.Type == rateLimitType && w.rule.Interval == interval {
+			return w
+		}
+	}
+	return nil
+}
+
+// reserve count cost if every limit allows it, otherwise it returns the
+// rule to wait for and how long
+func (l *RateLimiter) reserve(cost RequestCost) (*RateLimitError, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	var exceeded *RateLimitError
+	for _, w := range l.windows {
+		w.roll(now)
+		amount := w.amount(cost)
+		if amount <= 0 || w.used+amount <= w.rule.Limit {
+			continue
+		}
+		wait := w.start.Add(w.rule.Interval).Sub(now)
+		if exceeded == nil || wait > exceeded.RetryAfter {
+			exceeded = &RateLimitError{Rule: w.rule, RetryAfter: wait}
+		}
+		if amount > w.rule.Limit {
+			// never allowed, waiting would not help
+			return exceeded, false
+		}
+	}
+	if exceeded != nil {
+		return exceeded, true
+	}
+	for _, w := range l.windows {
+		w.used += w.amount(cost)
+	}
+	return nil, true
+}
+
+// Wait block until a request of cost fits in every limit and count it
+func (l *RateLimiter) Wait(ctx context.Context, cost RequestCost) error {
+	for {
+		exceeded, retry := l.reserve(cost)
+		if exceeded == nil {
+			return nil
+		}
+		if l.FailFast || !retry {
+			return *exceeded
+		}
+		if err := Sleep(ctx, exceeded.RetryAfter); err != nil {
+			return err
+		}
+	}
+}
+
+// Update reconcile the counters with the X-MBX-USED-WEIGHT-* and
+// X-MBX-ORDER-COUNT-* headers of a response, header can be nil
+func (l *RateLimiter) Update(header http.Header) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for key, values := range header {
+		if len(values) == 0 {
+			continue
+		}
+		key = strings.ToUpper(key)
+		var rateLimitType, interval string
+		switch {
+		case strings.HasPrefix(key, usedWeightHeaderPrefix):
+			rateLimitType, interval = RateLimitTypeRequestWeight, key[len(usedWeightHeaderPrefix):]
+		case strings.HasPrefix(key, orderCountHeaderPrefix):
+			rateLimitType, interval = RateLimitTypeOrders, key[len(orderCountHeaderPrefix):]
+		default:
+			continue
+		}
+		d, err := ParseHeaderInterval(interval)
+		if err != nil {
+			continue
+		}
+		used, err := strconv.ParseInt(values[0], 10, 64)
+		if err != nil {
+			continue
+		}
+		if w := l.window(rateLimitType, d); w != nil {
+			w.roll(now)
+			// requests still in flight are not counted by the server yet
+			if used > w.used {
+				w.used = used
+			}
+		}
+	}
+}
+
+// ParseHeaderInterval parse the interval suffix of the rate limit headers, e.g. 1M or 10S
+func ParseHeaderInterval(interval string) (time.Duration, error) {
+	if len(interval) < 2 {
+		return 0, fmt.Errorf("invalid rate limit interval %q", interval)
+	}
+	num, err := strconv.ParseInt(interval[:len(interval)-1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid rate limit interval %q", interval)
+	}
+	var unit time.Duration
+	switch interval[len(interval)-1] {
+	case 'S', 's':
+		unit = time.Second
+	case 'M', 'm':
+		unit = time.Minute
+	case 'H', 'h':
+		unit = time.Hour
+	case 'D', 'd':
+		unit = 24 * time.Hour
+	default:
+		return 0, fmt.Errorf("invalid rate limit interval %q", interval)
+	}
+	return time.Duration(num) * unit, nil
+}
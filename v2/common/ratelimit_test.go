@@ -0,0 +1,150 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestRateLimiter(now *time.Time, rules ...RateLimitRule) *RateLimiter {
+	l := NewRateLimiter(rules...)
+	l.now = func() time.Time {
+		return *now
+	}
+	return l
+}
+
+func TestNewRateLimitRule(t *testing.T) {
+	assert := assert.New(t)
+	rule, err := NewRateLimitRule(RateLimitTypeOrders, "SECOND", 10, 100)
+	assert.NoError(err)
+	assert.Equal(RateLimitRule{Type: RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 100}, rule)
+	rule, err = NewRateLimitRule(RateLimitTypeRequestWeight, "DAY", 0, 200)
+	assert.NoError(err)
+	assert.Equal(24*time.Hour, rule.Interval)
+	_, err = NewRateLimitRule(RateLimitTypeRequestWeight, "WEEK", 1, 200)
+	assert.Error(err)
+}
+
+func TestParseHeaderInterval(t *testing.T) {
+	assert := assert.New(t)
+	for interval, want := range map[string]time.Duration{
+		"1M":  time.Minute,
+		"10S": 10 * time.Second,
+		"1H":  time.Hour,
+		"1D":  24 * time.Hour,
+		"1m":  time.Minute,
+	} {
+		d, err := ParseHeaderInterval(interval)
+		assert.NoError(err, interval)
+		assert.Equal(want, d, interval)
+	}
+	for _, interval := range []string{"", "M", "1W", "XM"} {
+		_, err := ParseHeaderInterval(interval)
+		assert.Error(err, interval)
+	}
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC)
+	l := newTestRateLimiter(&now,
+		RateLimitRule{Type: RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 10},
+		RateLimitRule{Type: RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 2},
+		RateLimitRule{Type: RateLimitTypeRawRequests, Interval: 5 * time.Minute, Limit: 100},
+	)
+	l.FailFast = true
+	ctx := context.Background()
+
+	assert.NoError(l.Wait(ctx, RequestCost{Weight: 5, Orders: 1}))
+	assert.NoError(l.Wait(ctx, RequestCost{Weight: 1, Orders: 1}))
+	assert.Equal(int64(6), l.Used(RateLimitTypeRequestWeight, time.Minute))
+	assert.Equal(int64(2), l.Used(RateLimitTypeOrders, 10*time.Second))
+	assert.Equal(int64(2), l.Used(RateLimitTypeRawRequests, 5*time.Minute))
+
+	err := l.Wait(ctx, RequestCost{Weight: 1, Orders: 1})
+	assert.Equal(RateLimitError{
+		Rule:       RateLimitRule{Type: RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 2},
+		RetryAfter: 10 * time.Second,
+	}, err)
+	// nothing is counted when a request is rejected
+	assert.Equal(int64(6), l.Used(RateLimitTypeRequestWeight, time.Minute))
+
+	err = l.Wait(ctx, RequestCost{Weight: 5})
+	assert.Equal(RateLimitError{
+		Rule:       RateLimitRule{Type: RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 10},
+		RetryAfter: 50 * time.Second,
+	}, err)
+
+	// the orders window resets on its own boundary
+	now = now.Add(10 * time.Second)
+	assert.Equal(int64(0), l.Used(RateLimitTypeOrders, 10*time.Second))
+	assert.NoError(l.Wait(ctx, RequestCost{Weight: 1, Orders: 1}))
+	assert.Equal(int64(7), l.Used(RateLimitTypeRequestWeight, time.Minute))
+}
+
+func TestRateLimiterWait(t *testing.T) {
+	assert := assert.New(t)
+	l := NewRateLimiter(RateLimitRule{Type: RateLimitTypeRawRequests, Interval: time.Second, Limit: 1})
+	ctx := context.Background()
+	assert.NoError(l.Wait(ctx, RequestCost{}))
+	start := time.Now()
+	assert.NoError(l.Wait(ctx, RequestCost{}))
+	assert.True(time.Now().Truncate(time.Second).After(start.Truncate(time.Second)))
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	assert.Equal(context.Canceled, l.Wait(ctx, RequestCost{}))
+}
+
+func TestRateLimiterCostOverLimit(t *testing.T) {
+	l := NewRateLimiter(RateLimitRule{Type: RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 10})
+	err := l.Wait(context.Background(), RequestCost{Weight: 11})
+	assert.IsType(t, RateLimitError{}, err)
+}
+
+func TestRateLimiterUpdate(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC)
+	l := newTestRateLimiter(&now,
+		RateLimitRule{Type: RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 1200},
+		RateLimitRule{Type: RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 50},
+		RateLimitRule{Type: RateLimitTypeOrders, Interval: 24 * time.Hour, Limit: 160000},
+	)
+	assert.NoError(l.Wait(context.Background(), RequestCost{Weight: 5}))
+
+	header := http.Header{}
+	header.Set("X-MBX-USED-WEIGHT-1M", "600")
+	header.Set("X-MBX-ORDER-COUNT-10S", "3")
+	header.Set("X-MBX-ORDER-COUNT-1D", "20")
+	header.Set("X-MBX-USED-WEIGHT", "600")
+	header.Set("X-MBX-ORDER-COUNT-1H", "7")
+	l.Update(header)
+	assert.Equal(int64(600), l.Used(RateLimitTypeRequestWeight, time.Minute))
+	assert.Equal(int64(3), l.Used(RateLimitTypeOrders, 10*time.Second))
+	assert.Equal(int64(20), l.Used(RateLimitTypeOrders, 24*time.Hour))
+
+	// a lower count does not forget the requests in flight
+	header.Set("X-MBX-USED-WEIGHT-1M", "1")
+	l.Update(header)
+	assert.Equal(int64(600), l.Used(RateLimitTypeRequestWeight, time.Minute))
+
+	l.Update(nil)
+}
+
+func TestRateLimiterSetRules(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC)
+	weight := RateLimitRule{Type: RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 1200}
+	l := newTestRateLimiter(&now, weight)
+	assert.NoError(l.Wait(context.Background(), RequestCost{Weight: 5}))
+
+	weight.Limit = 2400
+	orders := RateLimitRule{Type: RateLimitTypeOrders, Interval: time.Minute, Limit: 1200}
+	l.SetRules(weight, orders)
+	assert.Equal([]RateLimitRule{weight, orders}, l.Rules())
+	assert.Equal(int64(5), l.Used(RateLimitTypeRequestWeight, time.Minute))
+}
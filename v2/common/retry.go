@@ -0,0 +1,93 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy define how requests failing with a transient error are sent again:
+// network errors, 5xx responses and 429/418 responses, which are retried after
+// their Retry-After header when there is one
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts including the first one
+	MaxAttempts int
+	// Backoff is the delay between attempts, DefaultBackoff if nil
+	Backoff BackoffPolicy
+	// MaxRetryAfter give up when the server asks to wait longer, 0 for no limit
+	MaxRetryAfter time.Duration
+}
+
+// NewRetryPolicy init a retry policy making at most maxAttempts attempts with the default backoff
+func NewRetryPolicy(maxAttempts int) *RetryPolicy {
+	return &RetryPolicy{MaxAttempts: maxAttempts}
+}
+
+// Delay return how long to wait before sending again a request whose attempt
+// failed with err, statusCode is 0 if no response was received. It returns
+// false if the request should not be sent again.
+func (p *RetryPolicy) Delay(attempt int, err error, statusCode int, header http.Header) (time.Duration, bool) {
+	if p == nil || err == nil || attempt >= p.MaxAttempts {
+		return 0, false
+	}
+	backoff := p.Backoff
+	if backoff == nil {
+		backoff = DefaultBackoff
+	}
+	switch {
+	case statusCode == http.StatusTooManyRequests || statusCode == http.StatusTeapot:
+		retryAfter, ok := ParseRetryAfter(header)
+		if !ok {
+			return backoff.Backoff(attempt), true
+		}
+		if p.MaxRetryAfter > 0 && retryAfter > p.MaxRetryAfter {
+			return 0, false
+		}
+		return retryAfter, true
+	case statusCode >= http.StatusInternalServerError:
+		return backoff.Backoff(attempt), true
+	case statusCode == 0 && isNetworkError(err):
+		return backoff.Backoff(attempt), true
+	}
+	return 0, false
+}
+
+// ParseRetryAfter parse the Retry-After header, in seconds
+func ParseRetryAfter(header http.Header) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(v, 10, 64)
+	if err != nil || n < 0 {
+		return 0, false
+	}
+	return time.Duration(n) * time.Second, true
+}
+
+func isNetworkError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var urlErr *url.Error
+	var netErr net.Error
+	return errors.As(err, &urlErr) || errors.As(err, &netErr) ||
+		errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// Sleep wait for d or until ctx is done
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
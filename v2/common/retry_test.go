@@ -0,0 +1,64 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicyDelay(t *testing.T) {
+	assert := assert.New(t)
+	p := &RetryPolicy{MaxAttempts: 3, Backoff: ConstantBackoff(time.Second), MaxRetryAfter: time.Minute}
+	apiErr := &APIError{Code: -1000, Message: "dummy"}
+	netErr := &url.Error{Op: "Get", URL: "https://api.binance.com", Err: errors.New("connection reset")}
+	retryAfter := func(v string) http.Header {
+		header := http.Header{}
+		header.Set("Retry-After", v)
+		return header
+	}
+
+	for _, c := range []struct {
+		name       string
+		attempt    int
+		err        error
+		statusCode int
+		header     http.Header
+		delay      time.Duration
+		ok         bool
+	}{
+		{"network error", 1, netErr, 0, nil, time.Second, true},
+		{"server error", 2, apiErr, http.StatusServiceUnavailable, nil, time.Second, true},
+		{"too many requests", 1, apiErr, http.StatusTooManyRequests, retryAfter("5"), 5 * time.Second, true},
+		{"banned", 1, apiErr, http.StatusTeapot, retryAfter("120"), 0, false},
+		{"no retry after", 1, apiErr, http.StatusTooManyRequests, nil, time.Second, true},
+		{"last attempt", 3, netErr, 0, nil, 0, false},
+		{"bad request", 1, apiErr, http.StatusBadRequest, nil, 0, false},
+		{"no error", 1, nil, http.StatusOK, nil, 0, false},
+		{"not a network error", 1, errors.New("invalid key"), 0, nil, 0, false},
+		{"canceled", 1, &url.Error{Op: "Get", Err: context.Canceled}, 0, nil, 0, false},
+	} {
+		delay, ok := p.Delay(c.attempt, c.err, c.statusCode, c.header)
+		assert.Equal(c.ok, ok, c.name)
+		assert.Equal(c.delay, delay, c.name)
+	}
+
+	var nilPolicy *RetryPolicy
+	_, ok := nilPolicy.Delay(1, netErr, 0, nil)
+	assert.False(ok)
+	delay, ok := NewRetryPolicy(2).Delay(1, netErr, 0, nil)
+	assert.True(ok)
+	assert.True(delay > 0)
+}
+
+func TestSleep(t *testing.T) {
+	assert := assert.New(t)
+	assert.NoError(Sleep(context.Background(), time.Millisecond))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.Equal(context.Canceled, Sleep(ctx, time.Minute))
+}
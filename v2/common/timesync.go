@@ -0,0 +1,152 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	defaultTimeSyncInterval  = time.Minute
+	defaultTimeSyncSmoothing = 0.2
+)
+
+// TimeSyncSample define the result of a sync, offsets are in milliseconds
+// and follow TimeOffset: local time minus server time
+type TimeSyncSample struct {
+	// Offset is the smoothed offset used to sign requests
+	Offset int64
+	// Measured is the offset measured by this sync
+	Measured int64
+	// Drift is how far the measured offset moved from the previous smoothed one
+	Drift int64
+	// RoundTrip is the duration of the server time request
+	RoundTrip time.Duration
+}
+
+// TimeSync keeps the offset between the local clock and the server time,
+// estimating the server time at the middle of the round trip of each request
+// and smoothing the measures. A TimeSync can be shared by several clients.
+type TimeSync struct {
+	// Interval between syncs, default 1 minute
+	Interval time.Duration
+	// Smoothing is the weight of a new measure in the offset, in (0, 1], default 0.2
+	Smoothing float64
+	// OnSync is called after each successful sync
+	OnSync func(sample TimeSyncSample)
+	// ErrHandler is called when a background sync fails
+	ErrHandler func(err error)
+
+	serverTime func(ctx context.Context) (int64, error)
+	now        func() time.Time
+
+	mu     sync.RWMutex
+	offset int64
+	synced bool
+	cancel context.CancelFunc
+}
+
+// NewTimeSync init a time sync fetching the server time in milliseconds with serverTime
+func NewTimeSync(serverTime func(ctx context.Context) (int64, error)) *TimeSync {
+	return &TimeSync{serverTime: serverTime, now: time.Now}
+}
+
+// Offset return the smoothed offset in milliseconds
+func (t *TimeSync) Offset() int64 {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.offset
+}
+
+// Sync measure the offset once and smooth it into the current one
+func (t *TimeSync) Sync(ctx context.Context) (TimeSyncSample, error) {
+	return t.sync(ctx, false)
+}
+
+// Resync measure the offset once and use it as is, e.g. after the server rejected a timestamp
+func (t *TimeSync) Resync(ctx context.Context) (TimeSyncSample, error) {
+	return t.sync(ctx, true)
+}
+
+func (t *TimeSync) sync(ctx context.Context, reset bool) (TimeSyncSample, error) {
+	start := t.now()
+	serverTime, err := t.serverTime(ctx)
+	if err != nil {
+		return TimeSyncSample{}, err
+	}
+	end := t.now()
+	rtt := end.Sub(start)
+	measured := start.Add(rtt/2).UnixNano()/int64(time.Millisecond) - serverTime
+
+	smoothing := t.Smoothing
+	if smoothing <= 0 || smoothing > 1 {
+		smoothing = defaultTimeSyncSmoothing
+	}
+	t.mu.Lock()
+	sample := TimeSyncSample{Measured: measured, RoundTrip: rtt}
+	if t.synced {
+		sample.Drift = measured - t.offset
+	}
+	if t.synced && !reset {
+		t.offset += int64(float64(measured-t.offset) * smoothing)
+	} else {
+		t.offset = measured
+	}
+	t.synced = true
+	sample.Offset = t.offset
+	t.mu.Unlock()
+
+	if t.OnSync != nil {
+		t.OnSync(sample)
+	}
+	return sample, nil
+}
+
+// Start sync the offset once then every Interval in the background until Stop
+// is called or ctx is done
+func (t *TimeSync) Start(ctx context.Context) error {
+	t.mu.Lock()
+	if t.cancel != nil {
+		t.mu.Unlock()
+		return errors.New("time sync already started")
+	}
+	ctx, t.cancel = context.WithCancel(ctx)
+	t.mu.Unlock()
+	if _, err := t.Resync(ctx); err != nil {
+		t.Stop()
+		return err
+	}
+	go t.run(ctx)
+	return nil
+}
+
+// Stop stop the background sync
+func (t *TimeSync) Stop() {
+	t.mu.Lock()
+	cancel := t.cancel
+	t.cancel = nil
+	t.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func (t *TimeSync) run(ctx context.Context) {
+	interval := t.Interval
+	if interval <= 0 {
+		interval = defaultTimeSyncInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := t.Sync(ctx); err != nil && ctx.Err() == nil && t.ErrHandler != nil {
+				t.ErrHandler(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeClock returns the server time of each request and moves the local
+// clock forward by the round trip
+type fakeClock struct {
+	mu         sync.Mutex
+	local      time.Time
+	serverTime int64
+	rtt        time.Duration
+	err        error
+}
+
+func (c *fakeClock) now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.local
+}
+
+func (c *fakeClock) fetch(ctx context.Context) (int64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.local = c.local.Add(c.rtt)
+	return c.serverTime, c.err
+}
+
+func TestTimeSync(t *testing.T) {
+	assert := assert.New(t)
+	clock := &fakeClock{
+		local:      time.Unix(1000, 0),
+		serverTime: 999000 + 50, // 1s behind, measured at the middle of the round trip
+		rtt:        100 * time.Millisecond,
+	}
+	ts := NewTimeSync(clock.fetch)
+	ts.now = clock.now
+	ts.Smoothing = 0.5
+	var samples []TimeSyncSample
+	ts.OnSync = func(sample TimeSyncSample) {
+		samples = append(samples, sample)
+	}
+
+	sample, err := ts.Sync(context.Background())
+	assert.NoError(err)
+	assert.Equal(TimeSyncSample{Offset: 1000, Measured: 1000, RoundTrip: 100 * time.Millisecond}, sample)
+	assert.Equal(int64(1000), ts.Offset())
+
+	// the local clock drifts 200ms further
+	clock.serverTime = 1000150 - 1200
+	sample, err = ts.Sync(context.Background())
+	assert.NoError(err)
+	assert.Equal(TimeSyncSample{Offset: 1100, Measured: 1200, Drift: 200, RoundTrip: 100 * time.Millisecond}, sample)
+
+	sample, err = ts.Resync(context.Background())
+	assert.NoError(err)
+	assert.Equal(int64(1300), sample.Offset)
+	assert.Len(samples, 3)
+
+	clock.err = errors.New("dummy error")
+	_, err = ts.Sync(context.Background())
+	assert.Error(err)
+	assert.Equal(int64(1300), ts.Offset())
+}
+
+func TestTimeSyncStart(t *testing.T) {
+	assert := assert.New(t)
+	clock := &fakeClock{local: time.Unix(1000, 0), serverTime: 999000}
+	ts := NewTimeSync(clock.fetch)
+	ts.now = clock.now
+	ts.Interval = time.Millisecond
+	synced := make(chan TimeSyncSample, 10)
+	ts.OnSync = func(sample TimeSyncSample) {
+		select {
+		case synced <- sample:
+		default:
+		}
+	}
+
+	assert.NoError(ts.Start(context.Background()))
+	assert.Error(ts.Start(context.Background()))
+	for i := 0; i < 2; i++ {
+		select {
+		case sample := <-synced:
+			assert.Equal(int64(1000), sample.Offset)
+		case <-time.After(5 * time.Second):
+			t.Fatal("not synced")
+		}
+	}
+	ts.Stop()
+
+	clock.mu.Lock()
+	clock.err = errors.New("dummy error")
+	clock.mu.Unlock()
+	assert.Error(NewTimeSync(clock.fetch).Start(context.Background()))
+}
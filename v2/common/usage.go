@@ -0,0 +1,57 @@
+package common
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Usage define the rate limit usage reported by the headers of a response
+type Usage struct {
+	// UsedWeight is the weight used in each interval, keyed by the header suffix e.g. 1M
+	UsedWeight map[string]int64
+	// OrderCount is the number of orders placed in each interval, keyed by the header suffix e.g. 10S
+	OrderCount map[string]int64
+	// RetryAfter is set on 418 and 429 responses
+	RetryAfter time.Duration
+	// Date is the server time of the response
+	Date time.Time
+	// Header is the raw response header
+	Header http.Header
+}
+
+// ParseUsage parse the X-MBX-USED-WEIGHT-*, X-MBX-ORDER-COUNT-*, Retry-After
+// and Date headers, header can be nil
+func ParseUsage(header http.Header) *Usage {
+	u := &Usage{
+		UsedWeight: map[string]int64{},
+		OrderCount: map[string]int64{},
+		Header:     header,
+	}
+	for key, values := range header {
+		if len(values) == 0 {
+			continue
+		}
+		key = strings.ToUpper(key)
+		var counts map[string]int64
+		switch {
+		case strings.HasPrefix(key, usedWeightHeaderPrefix):
+			counts, key = u.UsedWeight, key[len(usedWeightHeaderPrefix):]
+		case strings.HasPrefix(key, orderCountHeaderPrefix):
+			counts, key = u.OrderCount, key[len(orderCountHeaderPrefix):]
+		default:
+			continue
+		}
+		if n, err := strconv.ParseInt(values[0], 10, 64); err == nil {
+			counts[key] = n
+		}
+	}
+	u.RetryAfter, _ = ParseRetryAfter(header)
+	if v := header.Get("Date"); v != "" {
+		if t, err := http.ParseTime(v); err == nil {
+			u.Date = t
+		}
+	}
+	return u
+}
@@ -0,0 +1,33 @@
+package common
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseUsage(t *testing.T) {
+	assert := assert.New(t)
+	header := http.Header{}
+	header.Set("X-MBX-USED-WEIGHT-1M", "120")
+	header.Set("X-MBX-USED-WEIGHT", "120")
+	header.Set("X-MBX-ORDER-COUNT-10S", "3")
+	header.Set("X-MBX-ORDER-COUNT-1D", "42")
+	header.Set("Retry-After", "30")
+	header.Set("Date", "Mon, 01 Jan 2024 00:00:10 GMT")
+
+	u := ParseUsage(header)
+	assert.Equal(map[string]int64{"1M": 120}, u.UsedWeight)
+	assert.Equal(map[string]int64{"10S": 3, "1D": 42}, u.OrderCount)
+	assert.Equal(30*time.Second, u.RetryAfter)
+	assert.True(time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC).Equal(u.Date))
+	assert.Equal(header, u.Header)
+
+	u = ParseUsage(nil)
+	assert.Empty(u.UsedWeight)
+	assert.Empty(u.OrderCount)
+	assert.Zero(u.RetryAfter)
+	assert.True(u.Date.IsZero())
+}
@@ -0,0 +1,261 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+const defaultKeepaliveInterval = 30 * time.Minute
+
+// ErrUserStreamExpired is reported when the server expires the listen key of a user stream
+var ErrUserStreamExpired = errors.New("user data stream: listen key expired")
+
+// UserStreamGap define a period during which the user stream was down, the
+// events of the period may be lost and should be reconciled through REST
+type UserStreamGap struct {
+	Start time.Time
+	End   time.Time
+	// Err is why the stream went down
+	Err error
+}
+
+// UserStreamFuncs connect a UserStream to the listen key services and the user
+// data stream of a product
+type UserStreamFuncs[E any] struct {
+	Start     func(ctx context.Context) (listenKey string, err error)
+	Keepalive func(ctx context.Context, listenKey string) error
+	Close     func(ctx context.Context, listenKey string) error
+	Serve     func(listenKey string, handler func(event E), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+	// Expired tells if event is the listenKeyExpired event
+	Expired func(event E) bool
+}
+
+// UserStream keeps a user data stream running: it creates the listen key,
+// keeps it alive, and creates a new one and reconnects when the key expires or
+// the connection is lost. Set the handlers before calling Start.
+type UserStream[E any] struct {
+	// KeepaliveInterval between the keepalive requests, default 30 minutes
+	KeepaliveInterval time.Duration
+	// Backoff decides the delay before each attempt to restart the stream, DefaultBackoff is used if nil
+	Backoff BackoffPolicy
+	// Handler receive the events of the stream
+	Handler func(event E)
+	// ErrHandler receive the errors of the connection, the keepalive requests and the restarts
+	ErrHandler func(err error)
+	// OnGap is called once the stream is running again after it went down
+	OnGap func(gap UserStreamGap)
+
+	funcs UserStreamFuncs[E]
+
+	mu        sync.Mutex
+	listenKey string
+	cancel    context.CancelFunc
+	doneC     chan struct{}
+}
+
+// NewUserStream init a user stream of the product connected by funcs
+func NewUserStream[E any](funcs UserStreamFuncs[E]) *UserStream[E] {
+	return &UserStream[E]{funcs: funcs}
+}
+
+// ListenKey return the current listen key
+func (s *UserStream[E]) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listenKey
+}
+
+// Start create the listen key and connect the stream, the stream then runs in
+// the background until Stop is called or ctx is done
+func (s *UserStream[E]) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.cancel != nil {
+		s.mu.Unlock()
+		return errors.New("user data stream already started")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.doneC = make(chan struct{})
+	s.mu.Unlock()
+
+	c, err := s.connect(ctx)
+	if err != nil {
+		cancel()
+		s.mu.Lock()
+		s.cancel = nil
+		close(s.doneC)
+		s.mu.Unlock()
+		return err
+	}
+	go s.run(ctx, c)
+	return nil
+}
+
+// Stop disconnect the stream and close its listen key
+func (s *UserStream[E]) Stop() {
+	s.mu.Lock()
+	cancel, doneC := s.cancel, s.doneC
+	s.cancel = nil
+	s.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		<-doneC
+	}
+}
+
+// Done returns a channel which is closed once the stream stopped
+func (s *UserStream[E]) Done() <-chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.doneC
+}
+
+// userStreamConn is one connection of the stream with its listen key
+type userStreamConn struct {
+	listenKey string
+	doneC     chan struct{}
+	stopC     chan struct{}
+	expiredC  chan struct{}
+	lastErr   error
+	errMu     sync.Mutex
+}
+
+func (c *userStreamConn) setErr(err error) {
+	c.errMu.Lock()
+	c.lastErr = err
+	c.errMu.Unlock()
+}
+
+func (c *userStreamConn) err() error {
+	c.errMu.Lock()
+	defer c.errMu.Unlock()
+	return c.lastErr
+}
+
+func (s *UserStream[E]) connect(ctx context.Context) (*userStreamConn, error) {
+	listenKey, err := s.funcs.Start(ctx)
+	if err != nil {
+		return nil, err
+	}
+	c := &userStreamConn{listenKey: listenKey, expiredC: make(chan struct{})}
+	var once sync.Once
+	handler := func(event E) {
+		if s.funcs.Expired != nil && s.funcs.Expired(event) {
+			once.Do(func() { close(c.expiredC) })
+		}
+		if s.Handler != nil {
+			s.Handler(event)
+		}
+	}
+	errHandler := func(err error) {
+		c.setErr(err)
+		s.handleErr(err)
+	}
+	c.doneC, c.stopC, err = s.funcs.Serve(listenKey, handler, errHandler)
+	if err != nil {
+		s.closeListenKey(listenKey)
+		return nil, err
+	}
+	s.mu.Lock()
+	s.listenKey = listenKey
+	s.mu.Unlock()
+	return c, nil
+}
+
+func (s *UserStream[E]) run(ctx context.Context, c *userStreamConn) {
+	defer func() {
+		s.mu.Lock()
+		close(s.doneC)
+		s.mu.Unlock()
+	}()
+	interval := s.KeepaliveInterval
+	if interval <= 0 {
+		interval = defaultKeepaliveInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		var reason error
+		select {
+		case <-ctx.Done():
+			close(c.stopC)
+			<-c.doneC
+			s.closeListenKey(c.listenKey)
+			return
+		case <-ticker.C:
+			err := s.funcs.Keepalive(ctx, c.listenKey)
+			if err == nil || ctx.Err() != nil {
+				continue
+			}
+			s.handleErr(err)
+			if !errors.Is(err, ErrInvalidListenKey) {
+				continue
+			}
+			reason = err
+		case <-c.expiredC:
+			reason = ErrUserStreamExpired
+		case <-c.doneC:
+			reason = c.err()
+			if reason == nil {
+				reason = errors.New("user data stream: connection closed")
+			}
+		}
+
+		start := time.Now()
+		close(c.stopC)
+		<-c.doneC
+		if c = s.reconnect(ctx); c == nil {
+			return
+		}
+		ticker.Reset(interval)
+		if s.OnGap != nil {
+			s.OnGap(UserStreamGap{Start: start, End: time.Now(), Err: reason})
+		}
+	}
+}
+
+// reconnect create a listen key and connect until it succeeds, it returns nil if ctx is done
+func (s *UserStream[E]) reconnect(ctx context.Context) *userStreamConn {
+	backoff := s.Backoff
+	if backoff == nil {
+		backoff = DefaultBackoff
+	}
+	for attempt := 1; ; attempt++ {
+		timer := time.NewTimer(backoff.Backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		c, err := s.connect(ctx)
+		if err == nil {
+			return c
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		s.handleErr(err)
+	}
+}
+
+// closeListenKey close a listen key which is no longer used, even when the
+// context of the stream is done
+func (s *UserStream[E]) closeListenKey(listenKey string) {
+	if s.funcs.Close == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := s.funcs.Close(ctx, listenKey); err != nil {
+		s.handleErr(err)
+	}
+}
+
+func (s *UserStream[E]) handleErr(err error) {
+	if s.ErrHandler != nil {
+		s.ErrHandler(err)
+	}
+}
@@ -0,0 +1,197 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type fakeUserStreamConn struct {
+	handler    func(event string)
+	errHandler func(err error)
+	doneC      chan struct{}
+	stopC      chan struct{}
+}
+
+// fakeUserStream records the listen key requests and hands the connections to the test
+type fakeUserStream struct {
+	mu         sync.Mutex
+	keys       int
+	keepalives []string
+	closed     []string
+	keepErr    error
+	serveErrs  int
+	conns      chan *fakeUserStreamConn
+}
+
+func (f *fakeUserStream) funcs() UserStreamFuncs[string] {
+	return UserStreamFuncs[string]{
+		Start: func(ctx context.Context) (string, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.keys++
+			return fmt.Sprintf("key%d", f.keys), nil
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.keepalives = append(f.keepalives, listenKey)
+			return f.keepErr
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+			f.closed = append(f.closed, listenKey)
+			return nil
+		},
+		Serve: func(listenKey string, handler func(event string), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			f.mu.Lock()
+			if f.serveErrs > 0 {
+				f.serveErrs--
+				f.mu.Unlock()
+				return nil, nil, errors.New("dial failed")
+			}
+			f.mu.Unlock()
+			c := &fakeUserStreamConn{handler: handler, errHandler: errHandler, doneC: make(chan struct{}), stopC: make(chan struct{})}
+			go func() {
+				<-c.stopC
+				select {
+				case <-c.doneC:
+				default:
+					close(c.doneC)
+				}
+			}()
+			f.conns <- c
+			return c.doneC, c.stopC, nil
+		},
+		Expired: func(event string) bool {
+			return event == "listenKeyExpired"
+		},
+	}
+}
+
+type userStreamTestSuite struct {
+	suite.Suite
+	fake   *fakeUserStream
+	stream *UserStream[string]
+	events chan string
+	gaps   chan UserStreamGap
+}
+
+func TestUserStream(t *testing.T) {
+	suite.Run(t, new(userStreamTestSuite))
+}
+
+func (s *userStreamTestSuite) SetupTest() {
+	s.fake = &fakeUserStream{conns: make(chan *fakeUserStreamConn, 10)}
+	s.events = make(chan string, 10)
+	s.gaps = make(chan UserStreamGap, 10)
+	s.stream = NewUserStream(s.fake.funcs())
+	s.stream.Backoff = ConstantBackoff(time.Millisecond)
+	s.stream.Handler = func(event string) { s.events <- event }
+	s.stream.OnGap = func(gap UserStreamGap) { s.gaps <- gap }
+}
+
+func (s *userStreamTestSuite) conn() *fakeUserStreamConn {
+	select {
+	case c := <-s.fake.conns:
+		return c
+	case <-time.After(time.Second):
+		s.FailNow("no connection")
+	}
+	return nil
+}
+
+func (s *userStreamTestSuite) gap() UserStreamGap {
+	select {
+	case gap := <-s.gaps:
+		return gap
+	case <-time.After(time.Second):
+		s.FailNow("no gap")
+	}
+	return UserStreamGap{}
+}
+
+func (s *userStreamTestSuite) TestExpired() {
+	s.Require().NoError(s.stream.Start(context.Background()))
+	defer s.stream.Stop()
+	c := s.conn()
+	s.Equal("key1", s.stream.ListenKey())
+	c.handler("executionReport")
+	s.Equal("executionReport", <-s.events)
+
+	c.handler("listenKeyExpired")
+	s.Equal("listenKeyExpired", <-s.events)
+	s.conn()
+	s.Equal(ErrUserStreamExpired, s.gap().Err)
+	s.Equal("key2", s.stream.ListenKey())
+}
+
+func (s *userStreamTestSuite) TestDisconnected() {
+	s.Require().NoError(s.stream.Start(context.Background()))
+	c := s.conn()
+	connErr := errors.New("connection reset")
+	c.errHandler(connErr)
+	close(c.doneC)
+	s.conn()
+	gap := s.gap()
+	s.Equal(connErr, gap.Err)
+	s.False(gap.End.Before(gap.Start))
+
+	s.stream.Stop()
+	s.Equal([]string{"key2"}, s.fake.closed)
+	select {
+	case <-s.stream.Done():
+	default:
+		s.Fail("stream not stopped")
+	}
+}
+
+func (s *userStreamTestSuite) TestKeepalive() {
+	s.stream.KeepaliveInterval = 10 * time.Millisecond
+	s.Require().NoError(s.stream.Start(context.Background()))
+	defer s.stream.Stop()
+	s.conn()
+	s.Eventually(func() bool {
+		s.fake.mu.Lock()
+		defer s.fake.mu.Unlock()
+		return len(s.fake.keepalives) >= 2
+	}, time.Second, 5*time.Millisecond)
+
+	s.fake.mu.Lock()
+	s.fake.keepErr = &APIError{Code: -1125, Message: "This listenKey does not exist."}
+	s.fake.mu.Unlock()
+	s.conn()
+	s.True(errors.Is(s.gap().Err, ErrInvalidListenKey))
+}
+
+func (s *userStreamTestSuite) TestStartTwice() {
+	s.Require().NoError(s.stream.Start(context.Background()))
+	defer s.stream.Stop()
+	s.Error(s.stream.Start(context.Background()))
+}
+
+func (s *userStreamTestSuite) TestServeError() {
+	s.fake.serveErrs = 1
+	s.Error(s.stream.Start(context.Background()))
+	s.Equal([]string{"key1"}, s.fake.closed)
+
+	s.Require().NoError(s.stream.Start(context.Background()))
+	defer s.stream.Stop()
+	c := s.conn()
+	s.fake.mu.Lock()
+	s.fake.serveErrs = 2
+	s.fake.mu.Unlock()
+	close(c.doneC)
+	s.conn()
+	s.gap()
+	s.Equal("key5", s.stream.ListenKey())
+	s.fake.mu.Lock()
+	defer s.fake.mu.Unlock()
+	s.Equal([]string{"key1", "key3", "key4"}, s.fake.closed)
+}
@@ -0,0 +1,312 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// ErrWsAPINotConnected is returned when a request is sent before Connect or after the connection is lost
+var ErrWsAPINotConnected = errors.New("websocket api client is not connected")
+
+// WsAPISecType define how a websocket API request is authenticated
+type WsAPISecType int
+
+// Security types of the websocket API requests
+const (
+	WsAPISecTypeNone WsAPISecType = iota
+	WsAPISecTypeAPIKey
+	WsAPISecTypeSigned
+)
+
+// WsAPIResponse define a response of the websocket API, L is the type of its rate limits
+type WsAPIResponse[L any] struct {
+	ID         string          `json:"id"`
+	Status     int             `json:"status"`
+	Result     json.RawMessage `json:"result"`
+	Error      *APIError       `json:"error"`
+	RateLimits []L             `json:"rateLimits"`
+}
+
+// WsAPISessionStatus define the status of an authenticated websocket API session
+type WsAPISessionStatus struct {
+	APIKey           *string `json:"apiKey"`
+	AuthorizedSince  *int64  `json:"authorizedSince"`
+	ConnectedSince   int64   `json:"connectedSince"`
+	ReturnRateLimits bool    `json:"returnRateLimits"`
+	ServerTime       int64   `json:"serverTime"`
+}
+
+type wsAPIRequest struct {
+	ID     string                 `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params,omitempty"`
+}
+
+type wsAPIResult[L any] struct {
+	res *WsAPIResponse[L]
+	err error
+}
+
+// WsAPIConn is the connection of a websocket API client: requests are sent
+// over one persistent connection, signed unless the session is logged on, and
+// matched to their responses by id.
+type WsAPIConn[L any] struct {
+	APIKey     string
+	SecretKey  string
+	KeyType    string
+	Endpoint   string
+	TimeOffset int64
+
+	dial     func(endpoint string) (*websocket.Conn, error)
+	conn     *websocket.Conn
+	connMu   sync.RWMutex
+	writeMu  sync.Mutex
+	pendMu   sync.Mutex
+	pending  map[string]chan *wsAPIResult[L]
+	lastID   uint64
+	loggedOn int32
+	doneC    chan struct{}
+}
+
+// NewWsAPIConn init a connection to endpoint opened with dial, call Connect before sending requests
+func NewWsAPIConn[L any](apiKey, secretKey, endpoint string, dial func(endpoint string) (*websocket.Conn, error)) *WsAPIConn[L] {
+	return &WsAPIConn[L]{
+		APIKey:    apiKey,
+		SecretKey: secretKey,
+		KeyType:   KeyTypeHmac,
+		Endpoint:  endpoint,
+		dial:      dial,
+	}
+}
+
+// Connect dial the websocket API endpoint
+func (c *WsAPIConn[L]) Connect() error {
+	conn, err := c.dial(c.Endpoint)
+	if err != nil {
+		return err
+	}
+	doneC := make(chan struct{})
+	c.connMu.Lock()
+	c.conn = conn
+	c.doneC = doneC
+	c.connMu.Unlock()
+	atomic.StoreInt32(&c.loggedOn, 0)
+	go c.readLoop(conn, doneC)
+	return nil
+}
+
+// Close close the connection, pending requests fail with ErrWsAPINotConnected
+func (c *WsAPIConn[L]) Close() error {
+	c.connMu.RLock()
+	conn := c.conn
+	c.connMu.RUnlock()
+	if conn == nil {
+		return ErrWsAPINotConnected
+	}
+	return conn.Close()
+}
+
+// Done returns a channel which is closed when the connection is lost or closed
+func (c *WsAPIConn[L]) Done() <-chan struct{} {
+	c.connMu.RLock()
+	defer c.connMu.RUnlock()
+	return c.doneC
+}
+
+func (c *WsAPIConn[L]) readLoop(conn *websocket.Conn, doneC chan struct{}) {
+	defer close(doneC)
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			conn.Close()
+			c.connMu.Lock()
+			if c.conn == conn {
+				c.conn = nil
+			}
+			c.connMu.Unlock()
+			c.failPending(ErrWsAPINotConnected)
+			return
+		}
+		res := new(WsAPIResponse[L])
+		if err := json.Unmarshal(message, res); err != nil {
+			continue
+		}
+		c.pendMu.Lock()
+		resC, ok := c.pending[res.ID]
+		delete(c.pending, res.ID)
+		c.pendMu.Unlock()
+		if ok {
+			resC <- &wsAPIResult[L]{res: res}
+		}
+	}
+}
+
+func (c *WsAPIConn[L]) failPending(err error) {
+	c.pendMu.Lock()
+	defer c.pendMu.Unlock()
+	for id, resC := range c.pending {
+		resC <- &wsAPIResult[L]{err: err}
+		delete(c.pending, id)
+	}
+}
+
+func (c *WsAPIConn[L]) isLoggedOn() bool {
+	return atomic.LoadInt32(&c.loggedOn) == 1
+}
+
+// Send send a request with the given method and parameters and wait for its
+// response, recvWindow is left out if 0. Signed requests are authenticated with
+// the session if Logon succeeded, otherwise they carry the API key and a signature.
+func (c *WsAPIConn[L]) Send(ctx context.Context, method string, parameters map[string]interface{}, secType WsAPISecType, recvWindow int64) (*WsAPIResponse[L], error) {
+	p := make(map[string]interface{}, len(parameters)+4)
+	for k, v := range parameters {
+		p[k] = v
+	}
+	if recvWindow > 0 {
+		p["recvWindow"] = recvWindow
+	}
+	if secType == WsAPISecTypeAPIKey && !c.isLoggedOn() {
+		p["apiKey"] = c.APIKey
+	}
+	if secType == WsAPISecTypeSigned {
+		p["timestamp"] = time.Now().UnixMilli() - c.TimeOffset
+		if !c.isLoggedOn() {
+			p["apiKey"] = c.APIKey
+			if err := c.sign(p); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	c.connMu.RLock()
+	conn, doneC := c.conn, c.doneC
+	c.connMu.RUnlock()
+	if conn == nil {
+		return nil, ErrWsAPINotConnected
+	}
+
+	id := strconv.FormatUint(atomic.AddUint64(&c.lastID, 1), 10)
+	resC := make(chan *wsAPIResult[L], 1)
+	c.pendMu.Lock()
+	if c.pending == nil {
+		c.pending = make(map[string]chan *wsAPIResult[L])
+	}
+	c.pending[id] = resC
+	c.pendMu.Unlock()
+
+	c.writeMu.Lock()
+	err := conn.WriteJSON(&wsAPIRequest{ID: id, Method: method, Params: p})
+	c.writeMu.Unlock()
+	if err != nil {
+		c.removePending(id)
+		return nil, err
+	}
+
+	var result *wsAPIResult[L]
+	select {
+	case <-ctx.Done():
+		c.removePending(id)
+		return nil, ctx.Err()
+	case <-doneC:
+		select {
+		case result = <-resC:
+		default:
+			c.removePending(id)
+			return nil, ErrWsAPINotConnected
+		}
+	case result = <-resC:
+	}
+	if result.err != nil {
+		return nil, result.err
+	}
+	if result.res.Error != nil {
+		result.res.Error.StatusCode = result.res.Status
+		return result.res, result.res.Error
+	}
+	return result.res, nil
+}
+
+func (c *WsAPIConn[L]) removePending(id string) {
+	c.pendMu.Lock()
+	delete(c.pending, id)
+	c.pendMu.Unlock()
+}
+
+// sign add the signature of the parameters sorted by name to p
+func (c *WsAPIConn[L]) sign(p map[string]interface{}) error {
+	kt := c.KeyType
+	if kt == "" {
+		kt = KeyTypeHmac
+	}
+	sf, err := SignFunc(kt)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(p))
+	for k := range p {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = fmt.Sprintf("%s=%v", k, p[k])
+	}
+	sign, err := sf(c.SecretKey, strings.Join(pairs, "&"))
+	if err != nil {
+		return err
+	}
+	p["signature"] = *sign
+	return nil
+}
+
+// Logon authenticate the connection with an Ed25519 key, later signed
+// requests don't need to carry the API key and signature
+func (c *WsAPIConn[L]) Logon(ctx context.Context, recvWindow int64) (*WsAPISessionStatus, error) {
+	if c.KeyType != KeyTypeEd25519 {
+		return nil, fmt.Errorf("session logon requires keyType=%s, got keyType=%s", KeyTypeEd25519, c.KeyType)
+	}
+	atomic.StoreInt32(&c.loggedOn, 0)
+	res, err := c.session(ctx, "session.logon", WsAPISecTypeSigned, recvWindow)
+	if err != nil {
+		return nil, err
+	}
+	atomic.StoreInt32(&c.loggedOn, 1)
+	return res, nil
+}
+
+// Status query the status of the session
+func (c *WsAPIConn[L]) Status(ctx context.Context, recvWindow int64) (*WsAPISessionStatus, error) {
+	return c.session(ctx, "session.status", WsAPISecTypeNone, recvWindow)
+}
+
+// Logout forget the API key of the session, the connection stays open
+func (c *WsAPIConn[L]) Logout(ctx context.Context, recvWindow int64) (*WsAPISessionStatus, error) {
+	res, err := c.session(ctx, "session.logout", WsAPISecTypeNone, recvWindow)
+	if err != nil {
+		return nil, err
+	}
+	atomic.StoreInt32(&c.loggedOn, 0)
+	return res, nil
+}
+
+func (c *WsAPIConn[L]) session(ctx context.Context, method string, secType WsAPISecType, recvWindow int64) (*WsAPISessionStatus, error) {
+	res, err := c.Send(ctx, method, nil, secType, recvWindow)
+	if err != nil {
+		return nil, err
+	}
+	status := new(WsAPISessionStatus)
+	if err := json.Unmarshal(res.Result, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
@@ -0,0 +1,114 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testWsAPIRequest struct {
+	ID     string                 `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params"`
+}
+
+// newTestWsAPIServer echoes each request as its result. A "held" request is
+// signaled on heldC and answered after the next request.
+func newTestWsAPIServer(t *testing.T) (server *httptest.Server, endpoint string, heldC chan struct{}) {
+	upgrader := websocket.Upgrader{}
+	heldC = make(chan struct{}, 1)
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		var held *testWsAPIRequest
+		for {
+			req := new(testWsAPIRequest)
+			if err := conn.ReadJSON(req); err != nil {
+				return
+			}
+			if req.Method == "error" {
+				conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(
+					`{"id":"%s","status":400,"error":{"code":-1102,"msg":"Mandatory parameter was not sent."}}`, req.ID)))
+				continue
+			}
+			if req.Method == "held" {
+				held = req
+				heldC <- struct{}{}
+				continue
+			}
+			for _, r := range []*testWsAPIRequest{req, held} {
+				if r != nil {
+					conn.WriteJSON(map[string]interface{}{"id": r.ID, "status": 200, "result": r})
+				}
+			}
+			held = nil
+		}
+	}))
+	return server, "ws" + strings.TrimPrefix(server.URL, "http"), heldC
+}
+
+func TestWsAPIConn(t *testing.T) {
+	server, endpoint, heldC := newTestWsAPIServer(t)
+	defer server.Close()
+	c := NewWsAPIConn[struct{}]("apiKey", "secretKey", endpoint, func(endpoint string) (*websocket.Conn, error) {
+		conn, _, err := websocket.DefaultDialer.Dial(endpoint, nil)
+		return conn, err
+	})
+	ctx := context.Background()
+
+	_, err := c.Send(ctx, "ping", nil, WsAPISecTypeNone, 0)
+	assert.Equal(t, ErrWsAPINotConnected, err)
+	require.NoError(t, c.Connect())
+	defer c.Close()
+
+	res, err := c.Send(ctx, "order.place", map[string]interface{}{"symbol": "BTCUSDT"}, WsAPISecTypeSigned, 5000)
+	require.NoError(t, err)
+	req := new(testWsAPIRequest)
+	require.NoError(t, json.Unmarshal(res.Result, req))
+	assert.Equal(t, "order.place", req.Method)
+	assert.Equal(t, "apiKey", req.Params["apiKey"])
+	assert.EqualValues(t, 5000, req.Params["recvWindow"])
+	assert.Contains(t, req.Params, "timestamp")
+	assert.Contains(t, req.Params, "signature")
+
+	var wg sync.WaitGroup
+	methods := []string{"held", "released"}
+	for _, method := range methods {
+		wg.Add(1)
+		go func(method string) {
+			defer wg.Done()
+			res, err := c.Send(ctx, method, nil, WsAPISecTypeNone, 0)
+			if assert.NoError(t, err) {
+				req := new(testWsAPIRequest)
+				assert.NoError(t, json.Unmarshal(res.Result, req))
+				assert.Equal(t, method, req.Method)
+			}
+		}(method)
+		if method == "held" {
+			<-heldC
+		}
+	}
+	wg.Wait()
+
+	_, err = c.Send(ctx, "error", nil, WsAPISecTypeNone, 0)
+	apiErr, ok := err.(*APIError)
+	require.True(t, ok)
+	assert.Equal(t, int64(-1102), apiErr.Code)
+	assert.Equal(t, 400, apiErr.StatusCode)
+
+	_, err = c.Logon(ctx, 0)
+	assert.Error(t, err)
+}
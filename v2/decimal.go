@@ -0,0 +1,126 @@
+package binance
+
+import "github.com/adshao/go-binance/v2/common"
+
+// Decimal accessors of the string amounts of responses, invalid or empty
+// strings are 0. Use common.ParseDecimal to check the value.
+
+// PriceDecimal return Price as a decimal
+func (o *Order) PriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (o *Order) OrigQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (o *Order) ExecutedQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as a decimal
+func (o *Order) CummulativeQuoteQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.CummulativeQuoteQuantity)
+}
+
+// StopPriceDecimal return StopPrice as a decimal
+func (o *Order) StopPriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.StopPrice)
+}
+
+// IcebergQuantityDecimal return IcebergQuantity as a decimal
+func (o *Order) IcebergQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.IcebergQuantity)
+}
+
+// OrigQuoteOrderQuantityDecimal return OrigQuoteOrderQuantity as a decimal
+func (o *Order) OrigQuoteOrderQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.OrigQuoteOrderQuantity)
+}
+
+// PriceDecimal return Price as a decimal
+func (r *CreateOrderResponse) PriceDecimal() common.Decimal {
+	return common.DecimalOrZero(r.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (r *CreateOrderResponse) OrigQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(r.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (r *CreateOrderResponse) ExecutedQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(r.ExecutedQuantity)
+}
+
+// CummulativeQuoteQuantityDecimal return CummulativeQuoteQuantity as a decimal
+func (r *CreateOrderResponse) CummulativeQuoteQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(r.CummulativeQuoteQuantity)
+}
+
+// PriceDecimal return Price as a decimal
+func (f *Fill) PriceDecimal() common.Decimal {
+	return common.DecimalOrZero(f.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (f *Fill) QuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(f.Quantity)
+}
+
+// CommissionDecimal return Commission as a decimal
+func (f *Fill) CommissionDecimal() common.Decimal {
+	return common.DecimalOrZero(f.Commission)
+}
+
+// FreeDecimal return Free as a decimal
+func (b *Balance) FreeDecimal() common.Decimal {
+	return common.DecimalOrZero(b.Free)
+}
+
+// LockedDecimal return Locked as a decimal
+func (b *Balance) LockedDecimal() common.Decimal {
+	return common.DecimalOrZero(b.Locked)
+}
+
+// OpenDecimal return Open as a decimal
+func (k *Kline) OpenDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Open)
+}
+
+// HighDecimal return High as a decimal
+func (k *Kline) HighDecimal() common.Decimal {
+	return common.DecimalOrZero(k.High)
+}
+
+// LowDecimal return Low as a decimal
+func (k *Kline) LowDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Low)
+}
+
+// CloseDecimal return Close as a decimal
+func (k *Kline) CloseDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Close)
+}
+
+// VolumeDecimal return Volume as a decimal
+func (k *Kline) VolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return QuoteAssetVolume as a decimal
+func (k *Kline) QuoteAssetVolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal return TakerBuyBaseAssetVolume as a decimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal return TakerBuyQuoteAssetVolume as a decimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.TakerBuyQuoteAssetVolume)
+}
@@ -0,0 +1,68 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type decimalTestSuite struct {
+	baseTestSuite
+}
+
+func TestDecimal(t *testing.T) {
+	suite.Run(t, new(decimalTestSuite))
+}
+
+func (s *decimalTestSuite) TestCreateOrderDecimal() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 1,
+		"price": "0.0001",
+		"origQty": "12.00",
+		"executedQty": "10.00",
+		"cummulativeQuoteQty": "0.00100000",
+		"status": "PARTIALLY_FILLED",
+		"fills": [
+			{
+				"price": "0.0001",
+				"qty": "10.00",
+				"commission": "0.00000010",
+				"commissionAsset": "BTC"
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	price := common.MustParseDecimal("0.00012345").TruncateToStep(common.MustParseDecimal("0.0001"))
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   "LTCBTC",
+			"side":     SideTypeBuy,
+			"type":     OrderTypeLimit,
+			"quantity": "12.00",
+			"price":    "0.0001",
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeBuy).Type(OrderTypeLimit).
+		QuantityDecimal(common.MustParseDecimal("12.00")).PriceDecimal(price).Do(newContext())
+	s.r().NoError(err)
+	fill := res.Fills[0]
+	s.r().True(fill.PriceDecimal().Mul(fill.QuantityDecimal()).Equal(res.CummulativeQuoteQuantityDecimal()))
+	s.r().True(fill.CommissionDecimal().Equal(common.NewDecimal(1, 7)))
+}
+
+func (s *decimalTestSuite) TestOrderDecimal() {
+	order := &Order{Price: "0.1", OrigQuantity: "3", ExecutedQuantity: "1.5", StopPrice: ""}
+	s.r().Equal("1.5", order.OrigQuantityDecimal().Sub(order.ExecutedQuantityDecimal()).String())
+	s.r().Equal("0.3", order.PriceDecimal().Mul(order.OrigQuantityDecimal()).String())
+	s.r().True(order.StopPriceDecimal().IsZero())
+
+	balance := &Balance{Free: "0.1", Locked: "0.2"}
+	s.r().Equal("0.3", balance.FreeDecimal().Add(balance.LockedDecimal()).String())
+
+	kline := &Kline{High: "10.5", Low: "9.75"}
+	s.r().Equal("0.75", kline.HighDecimal().Sub(kline.LowDecimal()).String())
+}
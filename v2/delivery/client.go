@@ -47,12 +47,18 @@ type SymbolStatusType string
 // SymbolFilterType define symbol filter type
 type SymbolFilterType string
 
+// SymbolChangeType define the type of a symbol change
+type SymbolChangeType string
+
 // SideEffectType define side effect type for orders
 type SideEffectType string
 
 // WorkingType define working type
 type WorkingType string
 
+// PriceMatchType define price match mode of an order
+type PriceMatchType string
+
 // MarginType define margin type
 type MarginType string
 
@@ -101,6 +107,7 @@ const (
 	OrderExecutionTypeCalculated  OrderExecutionType = "CALCULATED"
 	OrderExecutionTypeExpired     OrderExecutionType = "EXPIRED"
 	OrderExecutionTypeTrade       OrderExecutionType = "TRADE"
+	OrderExecutionTypeAmendment   OrderExecutionType = "AMENDMENT"
 
 	OrderStatusTypeNew             OrderStatusType = "NEW"
 	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
@@ -114,6 +121,16 @@ const (
 	WorkingTypeMarkPrice     WorkingType = "MARK_PRICE"
 	WorkingTypeContractPrice WorkingType = "CONTRACT_PRICE"
 
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+
 	SymbolStatusTypePreTrading   SymbolStatusType = "PRE_TRADING"
 	SymbolStatusTypeTrading      SymbolStatusType = "TRADING"
 	SymbolStatusTypePostTrading  SymbolStatusType = "POST_TRADING"
@@ -129,6 +146,11 @@ const (
 	SymbolFilterTypeMaxNumOrders     SymbolFilterType = "MAX_NUM_ORDERS"
 	SymbolFilterTypeMaxNumAlgoOrders SymbolFilterType = "MAX_NUM_ALGO_ORDERS"
 
+	SymbolChangeTypeAdded   SymbolChangeType = "ADDED"
+	SymbolChangeTypeRemoved SymbolChangeType = "REMOVED"
+	SymbolChangeTypeStatus  SymbolChangeType = "STATUS"
+	SymbolChangeTypeFilters SymbolChangeType = "FILTERS"
+
 	SideEffectTypeNoSideEffect SideEffectType = "NO_SIDE_EFFECT"
 	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
 	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
@@ -232,7 +254,13 @@ type Client struct {
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
-	do         doFunc
+	// RateLimiter limits the requests before they are sent, nil disables it
+	RateLimiter *common.RateLimiter
+	// RetryPolicy sends again the requests failing with a transient error, nil disables it
+	RetryPolicy *common.RetryPolicy
+	// TimeSync replaces TimeOffset to sign requests when it is set
+	TimeSync *common.TimeSync
+	do       doFunc
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -256,7 +284,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -305,14 +333,64 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	return nil
 }
 
+// timeOffset return the offset to sign requests with
+func (c *Client) timeOffset() int64 {
+	if c.TimeSync != nil {
+		return c.TimeSync.Offset()
+	}
+	return c.TimeOffset
+}
+
+// resyncTime resync TimeSync when a signed request was rejected for its
+// timestamp, it tells if the request can be sent again
+func (c *Client) resyncTime(ctx context.Context, r *request, err error) bool {
+	if c.TimeSync == nil || r.secType != secTypeSigned || !common.IsTimestampOutOfRecvWindow(err) {
+		return false
+	}
+	_, err = c.TimeSync.Resync(ctx)
+	return err == nil
+}
+
+// callAPI send r, requests failing with a transient error are sent again
+// according to RetryPolicy when it is safe to do so, and signed requests
+// rejected for their timestamp are sent again once after resyncing TimeSync
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, err error) {
+	resynced := false
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		var header http.Header
+		data, statusCode, header, err = c.send(ctx, r, opts...)
+		if err == nil {
+			return data, err
+		}
+		// the options were applied to r by the first attempt
+		opts = nil
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			continue
+		}
+		if c.RetryPolicy == nil || !retryable(r) {
+			return data, err
+		}
+		delay, ok := c.RetryPolicy.Delay(attempt, err, statusCode, header)
+		if !ok {
+			return data, err
+		}
+		c.debug("attempt %d failed, retry in %s: %s\n", attempt, delay, err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, err
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, r *request, opts ...RequestOption) (data []byte, statusCode int, header http.Header, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
@@ -321,13 +399,26 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
+	if c.RateLimiter != nil {
+		if cost, ok := requestCost(r); ok {
+			if err = c.RateLimiter.Wait(ctx, cost); err != nil {
+				return []byte{}, 0, nil, err
+			}
+		}
+	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
+	}
+	if c.RateLimiter != nil {
+		c.RateLimiter.Update(res.Header)
+	}
+	if r.usage != nil {
+		*r.usage = *common.ParseUsage(res.Header)
 	}
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, err
+		return []byte{}, 0, nil, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -350,9 +441,11 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, apiErr
+		apiErr.StatusCode = res.StatusCode
+		apiErr.RetryAfter, _ = common.ParseRetryAfter(res.Header)
+		return nil, res.StatusCode, res.Header, apiErr
 	}
-	return data, nil
+	return data, res.StatusCode, res.Header, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -376,11 +469,106 @@ func (c *Client) NewSetServerTimeService() *SetServerTimeService {
 	return &SetServerTimeService{c: c}
 }
 
+// NewDepthService init depth service
+func (c *Client) NewDepthService() *DepthService {
+	return &DepthService{c: c}
+}
+
 // NewKlinesService init klines service
 func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
 }
 
+// NewKlineDownloadService init kline download service
+func (c *Client) NewKlineDownloadService() *KlineDownloadService {
+	return &KlineDownloadService{c: c}
+}
+
+// NewAggTradesService init aggregate trades service
+func (c *Client) NewAggTradesService() *AggTradesService {
+	return &AggTradesService{c: c}
+}
+
+// NewRecentTradesService init recent trades service
+func (c *Client) NewRecentTradesService() *RecentTradesService {
+	return &RecentTradesService{c: c}
+}
+
+// NewHistoricalTradesService init historical trades service
+func (c *Client) NewHistoricalTradesService() *HistoricalTradesService {
+	return &HistoricalTradesService{c: c}
+}
+
+// NewContinuousKlinesService init continuous klines service
+func (c *Client) NewContinuousKlinesService() *ContinuousKlinesService {
+	return &ContinuousKlinesService{c: c}
+}
+
+// NewIndexPriceKlinesService init index price klines service
+func (c *Client) NewIndexPriceKlinesService() *IndexPriceKlinesService {
+	return &IndexPriceKlinesService{c: c}
+}
+
+// NewMarkPriceKlinesService init mark price klines service
+func (c *Client) NewMarkPriceKlinesService() *MarkPriceKlinesService {
+	return &MarkPriceKlinesService{c: c}
+}
+
+// NewPremiumIndexKlinesService init premium index klines service
+func (c *Client) NewPremiumIndexKlinesService() *PremiumIndexKlinesService {
+	return &PremiumIndexKlinesService{c: c}
+}
+
+// NewPremiumIndexService init premium index service
+func (c *Client) NewPremiumIndexService() *PremiumIndexService {
+	return &PremiumIndexService{c: c}
+}
+
+// NewFundingRateService init funding rate service
+func (c *Client) NewFundingRateService() *FundingRateService {
+	return &FundingRateService{c: c}
+}
+
+// NewFundingRateInfoService init funding rate info service
+func (c *Client) NewFundingRateInfoService() *FundingRateInfoService {
+	return &FundingRateInfoService{c: c}
+}
+
+// NewGetOpenInterestService init open interest service
+func (c *Client) NewGetOpenInterestService() *GetOpenInterestService {
+	return &GetOpenInterestService{c: c}
+}
+
+// NewOpenInterestStatisticsService init open interest statistics service
+func (c *Client) NewOpenInterestStatisticsService() *OpenInterestStatisticsService {
+	return &OpenInterestStatisticsService{c: c}
+}
+
+// NewTopLongShortAccountRatioService init top long short account ratio service
+func (c *Client) NewTopLongShortAccountRatioService() *TopLongShortAccountRatioService {
+	return &TopLongShortAccountRatioService{c: c}
+}
+
+// NewTopLongShortPositionRatioService init top long short position ratio service
+func (c *Client) NewTopLongShortPositionRatioService() *TopLongShortPositionRatioService {
+	return &TopLongShortPositionRatioService{c: c}
+}
+
+// NewLongShortRatioService init long short ratio service
+func (c *Client) NewLongShortRatioService() *LongShortRatioService {
+	return &LongShortRatioService{c: c}
+}
+
+// NewTakerBuySellVolumeService init taker buy sell volume service
+func (c *Client) NewTakerBuySellVolumeService() *TakerBuySellVolumeService {
+	return &TakerBuySellVolumeService{c: c}
+}
+
+// NewBasisService init basis service
+func (c *Client) NewBasisService() *BasisService {
+	return &BasisService{c: c}
+}
+
 // NewListPriceChangeStatsService init list prices change stats service
 func (c *Client) NewListPriceChangeStatsService() *ListPriceChangeStatsService {
 	return &ListPriceChangeStatsService{c: c}
@@ -416,6 +604,11 @@ func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
 }
 
+// NewSymbolRegistry init symbol registry
+func (c *Client) NewSymbolRegistry() *SymbolRegistry {
+	return &SymbolRegistry{c: c}
+}
+
 // NewCreateOrderService init creating order service
 func (c *Client) NewCreateOrderService() *CreateOrderService {
 	return &CreateOrderService{c: c}
@@ -436,6 +629,26 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewModifyOrderService init modifying order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modifying batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
+// NewGetOrderModifyHistoryService init getting order modification history service
+func (c *Client) NewGetOrderModifyHistoryService() *GetOrderModifyHistoryService {
+	return &GetOrderModifyHistoryService{c: c}
+}
+
+// NewCancelMultipleOrdersService init cancel multiple orders service
+func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
+	return &CancelMultiplesOrdersService{c: c}
+}
+
 // NewListOpenOrdersService init list open orders service
 func (c *Client) NewListOpenOrdersService() *ListOpenOrdersService {
 	return &ListOpenOrdersService{c: c}
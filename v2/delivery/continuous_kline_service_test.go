@@ -0,0 +1,59 @@
+package delivery
+
+func (s *klineServiceTestSuite) TestContinuousKlines() {
+	data := []byte(`[
+        [
+			1591258320000,
+			"9640.7",
+			"9642.4",
+			"9640.6",
+			"9642.0",
+			"0",
+			1591258379999,
+			"0",
+			60,
+			"0",
+			"0",
+			"0"
+        ]
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	contractType := "PERPETUAL"
+	interval := "1m"
+	limit := 10
+	startTime := int64(1591258320000)
+	endTime := int64(1591258379999)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": contractType,
+			"interval":     interval,
+			"limit":        limit,
+			"startTime":    startTime,
+			"endTime":      endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	klines, err := s.client.NewContinuousKlinesService().Pair(pair).ContractType(contractType).
+		Interval(interval).Limit(limit).StartTime(startTime).
+		EndTime(endTime).Do(newContext())
+	s.r().NoError(err)
+	s.Len(klines, 1)
+	kline1 := &Kline{
+		OpenTime:                 1591258320000,
+		Open:                     "9640.7",
+		High:                     "9642.4",
+		Low:                      "9640.6",
+		Close:                    "9642.0",
+		Volume:                   "0",
+		CloseTime:                1591258379999,
+		QuoteAssetVolume:         "0",
+		TradeNum:                 60,
+		TakerBuyBaseAssetVolume:  "0",
+		TakerBuyQuoteAssetVolume: "0",
+	}
+	s.assertKlineEqual(kline1, klines[0])
+}
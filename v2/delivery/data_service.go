@@ -0,0 +1,418 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// TopLongShortAccountRatioService list the long/short account ratio of the top traders of a pair
+type TopLongShortAccountRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *TopLongShortAccountRatioService) Pair(pair string) *TopLongShortAccountRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period interval
+func (s *TopLongShortAccountRatioService) Period(period string) *TopLongShortAccountRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TopLongShortAccountRatioService) Limit(limit int) *TopLongShortAccountRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortAccountRatioService) StartTime(startTime int64) *TopLongShortAccountRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortAccountRatioService) EndTime(endTime int64) *TopLongShortAccountRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortAccountRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*TopLongShortAccountRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/topLongShortAccountRatio",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TopLongShortAccountRatio{}, err
+	}
+	res = make([]*TopLongShortAccountRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TopLongShortAccountRatio{}, err
+	}
+	return res, nil
+}
+
+// TopLongShortAccountRatio define top trader long/short account ratio
+type TopLongShortAccountRatio struct {
+	Pair           string `json:"pair"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongAccount    string `json:"longAccount"`
+	ShortAccount   string `json:"shortAccount"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// TopLongShortPositionRatioService list the long/short position ratio of the top traders of a pair
+type TopLongShortPositionRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *TopLongShortPositionRatioService) Pair(pair string) *TopLongShortPositionRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period interval
+func (s *TopLongShortPositionRatioService) Period(period string) *TopLongShortPositionRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TopLongShortPositionRatioService) Limit(limit int) *TopLongShortPositionRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TopLongShortPositionRatioService) StartTime(startTime int64) *TopLongShortPositionRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TopLongShortPositionRatioService) EndTime(endTime int64) *TopLongShortPositionRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TopLongShortPositionRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*TopLongShortPositionRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/topLongShortPositionRatio",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TopLongShortPositionRatio{}, err
+	}
+	res = make([]*TopLongShortPositionRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TopLongShortPositionRatio{}, err
+	}
+	return res, nil
+}
+
+// TopLongShortPositionRatio define top trader long/short position ratio
+type TopLongShortPositionRatio struct {
+	Pair           string `json:"pair"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongPosition   string `json:"longPosition"`
+	ShortPosition  string `json:"shortPosition"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// LongShortRatioService list the long/short account ratio of all the traders of a pair
+type LongShortRatioService struct {
+	c         *Client
+	pair      string
+	period    string
+	limit     *int
+	startTime *int64
+	endTime   *int64
+}
+
+// Pair set pair
+func (s *LongShortRatioService) Pair(pair string) *LongShortRatioService {
+	s.pair = pair
+	return s
+}
+
+// Period set period interval
+func (s *LongShortRatioService) Period(period string) *LongShortRatioService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *LongShortRatioService) Limit(limit int) *LongShortRatioService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *LongShortRatioService) StartTime(startTime int64) *LongShortRatioService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *LongShortRatioService) EndTime(endTime int64) *LongShortRatioService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *LongShortRatioService) Do(ctx context.Context, opts ...RequestOption) (res []*LongShortRatio, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/globalLongShortAccountRatio",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	res = make([]*LongShortRatio, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*LongShortRatio{}, err
+	}
+	return res, nil
+}
+
+// LongShortRatio define long/short account ratio
+type LongShortRatio struct {
+	Pair           string `json:"pair"`
+	LongShortRatio string `json:"longShortRatio"`
+	LongAccount    string `json:"longAccount"`
+	ShortAccount   string `json:"shortAccount"`
+	Timestamp      int64  `json:"timestamp"`
+}
+
+// TakerBuySellVolumeService list the taker buy/sell volume of a contract type of a pair
+type TakerBuySellVolumeService struct {
+	c            *Client
+	pair         string
+	contractType string
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *TakerBuySellVolumeService) Pair(pair string) *TakerBuySellVolumeService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, PERPETUAL, CURRENT_QUARTER or NEXT_QUARTER
+func (s *TakerBuySellVolumeService) ContractType(contractType string) *TakerBuySellVolumeService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period interval
+func (s *TakerBuySellVolumeService) Period(period string) *TakerBuySellVolumeService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *TakerBuySellVolumeService) Limit(limit int) *TakerBuySellVolumeService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *TakerBuySellVolumeService) StartTime(startTime int64) *TakerBuySellVolumeService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *TakerBuySellVolumeService) EndTime(endTime int64) *TakerBuySellVolumeService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *TakerBuySellVolumeService) Do(ctx context.Context, opts ...RequestOption) (res []*TakerBuySellVolume, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/takerBuySellVol",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("contractType", s.contractType)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	res = make([]*TakerBuySellVolume, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*TakerBuySellVolume{}, err
+	}
+	return res, nil
+}
+
+// TakerBuySellVolume define taker buy/sell volume
+type TakerBuySellVolume struct {
+	Pair              string `json:"pair"`
+	ContractType      string `json:"contractType"`
+	TakerBuyVol       string `json:"takerBuyVol"`
+	TakerSellVol      string `json:"takerSellVol"`
+	TakerBuyVolValue  string `json:"takerBuyVolValue"`
+	TakerSellVolValue string `json:"takerSellVolValue"`
+	Timestamp         int64  `json:"timestamp"`
+}
+
+// BasisService list the basis of a contract type of a pair
+type BasisService struct {
+	c            *Client
+	pair         string
+	contractType string
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *BasisService) Pair(pair string) *BasisService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, PERPETUAL, CURRENT_QUARTER or NEXT_QUARTER
+func (s *BasisService) ContractType(contractType string) *BasisService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period interval
+func (s *BasisService) Period(period string) *BasisService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *BasisService) Limit(limit int) *BasisService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *BasisService) StartTime(startTime int64) *BasisService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *BasisService) EndTime(endTime int64) *BasisService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *BasisService) Do(ctx context.Context, opts ...RequestOption) (res []*Basis, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/basis",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("contractType", s.contractType)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	res = make([]*Basis, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Basis{}, err
+	}
+	return res, nil
+}
+
+// Basis define basis
+type Basis struct {
+	Pair                string `json:"pair"`
+	ContractType        string `json:"contractType"`
+	FuturesPrice        string `json:"futuresPrice"`
+	IndexPrice          string `json:"indexPrice"`
+	Basis               string `json:"basis"`
+	BasisRate           string `json:"basisRate"`
+	AnnualizedBasisRate string `json:"annualizedBasisRate"`
+	Timestamp           int64  `json:"timestamp"`
+}
@@ -0,0 +1,228 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type dataServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestDataService(t *testing.T) {
+	suite.Run(t, new(dataServiceTestSuite))
+}
+
+func (s *dataServiceTestSuite) TestTopLongShortAccountRatio() {
+	data := []byte(`[
+        {
+            "pair": "BTCUSD",
+            "longShortRatio": "1.8105",
+            "longAccount": "0.6442",
+            "shortAccount": "0.3558",
+            "timestamp": 1583139600000
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "5m"
+	limit := 10
+	startTime := int64(1583139600000)
+	endTime := int64(1583139900000)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":      pair,
+			"period":    period,
+			"limit":     limit,
+			"startTime": startTime,
+			"endTime":   endTime,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewTopLongShortAccountRatioService().Pair(pair).
+		Period(period).Limit(limit).StartTime(startTime).EndTime(endTime).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &TopLongShortAccountRatio{
+		Pair:           "BTCUSD",
+		LongShortRatio: "1.8105",
+		LongAccount:    "0.6442",
+		ShortAccount:   "0.3558",
+		Timestamp:      1583139600000,
+	}
+	r.Equal(e, res[0])
+}
+
+func (s *dataServiceTestSuite) TestTopLongShortPositionRatio() {
+	data := []byte(`[
+        {
+            "pair": "BTCUSD",
+            "longShortRatio": "1.4342",
+            "longPosition": "0.5891",
+            "shortPosition": "0.4108",
+            "timestamp": 1583139600000
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "5m"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   pair,
+			"period": period,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewTopLongShortPositionRatioService().Pair(pair).
+		Period(period).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &TopLongShortPositionRatio{
+		Pair:           "BTCUSD",
+		LongShortRatio: "1.4342",
+		LongPosition:   "0.5891",
+		ShortPosition:  "0.4108",
+		Timestamp:      1583139600000,
+	}
+	r.Equal(e, res[0])
+}
+
+func (s *dataServiceTestSuite) TestLongShortRatio() {
+	data := []byte(`[
+        {
+            "pair": "BTCUSD",
+            "longShortRatio": "0.1960",
+            "longAccount": "0.6622",
+            "shortAccount": "0.3378",
+            "timestamp": 1583139600000
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	period := "5m"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":   pair,
+			"period": period,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewLongShortRatioService().Pair(pair).
+		Period(period).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &LongShortRatio{
+		Pair:           "BTCUSD",
+		LongShortRatio: "0.1960",
+		LongAccount:    "0.6622",
+		ShortAccount:   "0.3378",
+		Timestamp:      1583139600000,
+	}
+	r.Equal(e, res[0])
+}
+
+func (s *dataServiceTestSuite) TestTakerBuySellVolume() {
+	data := []byte(`[
+        {
+            "pair": "BTCUSD",
+            "contractType": "CURRENT_QUARTER",
+            "takerBuyVol": "387",
+            "takerSellVol": "248",
+            "takerBuyVolValue": "2342.1220",
+            "takerSellVolValue": "4213.9800",
+            "timestamp": 1591261042378
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	contractType := "CURRENT_QUARTER"
+	period := "5m"
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": contractType,
+			"period":       period,
+			"limit":        limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewTakerBuySellVolumeService().Pair(pair).
+		ContractType(contractType).Period(period).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &TakerBuySellVolume{
+		Pair:              "BTCUSD",
+		ContractType:      "CURRENT_QUARTER",
+		TakerBuyVol:       "387",
+		TakerSellVol:      "248",
+		TakerBuyVolValue:  "2342.1220",
+		TakerSellVolValue: "4213.9800",
+		Timestamp:         1591261042378,
+	}
+	r.Equal(e, res[0])
+}
+
+func (s *dataServiceTestSuite) TestBasis() {
+	data := []byte(`[
+        {
+            "indexPrice": "29269.93972727",
+            "contractType": "CURRENT_QUARTER",
+            "basisRate": "0.0024",
+            "futuresPrice": "29341.3",
+            "annualizedBasisRate": "0.0283",
+            "basis": "71.36027273",
+            "pair": "BTCUSD",
+            "timestamp": 1653381600000
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	contractType := "CURRENT_QUARTER"
+	period := "5m"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair":         pair,
+			"contractType": contractType,
+			"period":       period,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewBasisService().Pair(pair).
+		ContractType(contractType).Period(period).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &Basis{
+		Pair:                "BTCUSD",
+		ContractType:        "CURRENT_QUARTER",
+		FuturesPrice:        "29341.3",
+		IndexPrice:          "29269.93972727",
+		Basis:               "71.36027273",
+		BasisRate:           "0.0024",
+		AnnualizedBasisRate: "0.0283",
+		Timestamp:           1653381600000,
+	}
+	r.Equal(e, res[0])
+}
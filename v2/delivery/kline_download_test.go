@@ -0,0 +1,68 @@
+package delivery
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type klineDownloadTestSuite struct {
+	baseTestSuite
+}
+
+func TestKlineDownload(t *testing.T) {
+	suite.Run(t, new(klineDownloadTestSuite))
+}
+
+func (s *klineDownloadTestSuite) TestDownload() {
+	var paths []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		start, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		paths = append(paths, req.URL.Path)
+		var klines []string
+		for t := start; t <= end && t < 120000; t += 60000 {
+			klines = append(klines, fmt.Sprintf(`[%d, "1.0", "2.0", "0.5", "1.5", "10", %d, "15", 3, "5", "7.5", "0"]`, t, t+59999))
+		}
+		return newHTTPResponse([]byte("["+strings.Join(klines, ",")+"]"), http.StatusOK), nil
+	}
+	res, err := s.client.NewKlineDownloadService().Symbol("BTCUSD_PERP").Interval("1m").
+		StartTime(0).EndTime(240000).Limit(3).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]string{"/dapi/v1/klines", "/dapi/v1/klines"}, paths)
+	r.Len(res.Klines, 2)
+	r.Equal([]common.KlineGap{{Start: 120000, End: 240000}}, res.Gaps)
+}
+
+func (s *klineDownloadTestSuite) TestDownloadMaxSpan() {
+	const day = int64(24 * 60 * 60 * 1000)
+	var ranges [][2]int64
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		start, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		ranges = append(ranges, [2]int64{start, end})
+		var klines []string
+		for t := start; t <= end; t += day {
+			klines = append(klines, fmt.Sprintf(`[%d, "1.0", "2.0", "0.5", "1.5", "10", %d, "15", 3, "5", "7.5", "0"]`, t, t+day-1))
+		}
+		return newHTTPResponse([]byte("["+strings.Join(klines, ",")+"]"), http.StatusOK), nil
+	}
+	res, err := s.client.NewKlineDownloadService().Symbol("BTCUSD_PERP").Interval("1d").
+		StartTime(0).EndTime(1000 * day).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(ranges, 5)
+	for _, rg := range ranges {
+		r.LessOrEqual(rg[1]-rg[0], maxKlinesSpan)
+	}
+	r.Len(res.Klines, 1000)
+	r.Empty(res.Gaps)
+}
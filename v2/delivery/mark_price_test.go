@@ -0,0 +1,158 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type premiumIndexServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestPremiumIndexService(t *testing.T) {
+	suite.Run(t, new(premiumIndexServiceTestSuite))
+}
+
+func (s *premiumIndexServiceTestSuite) TestGetPremiumIndex() {
+	data := []byte(`[
+        {
+            "symbol": "BTCUSD_PERP",
+            "pair": "BTCUSD",
+            "markPrice": "11029.69574559",
+            "indexPrice": "10979.14437500",
+            "estimatedSettlePrice": "10981.74168236",
+            "lastFundingRate": "0.00071003",
+            "interestRate": "0.00010000",
+            "nextFundingTime": 1596096000000,
+            "time": 1596094042000
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	pair := "BTCUSD"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"pair": pair,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewPremiumIndexService().Pair(pair).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &PremiumIndex{
+		Symbol:               "BTCUSD_PERP",
+		Pair:                 "BTCUSD",
+		MarkPrice:            "11029.69574559",
+		IndexPrice:           "10979.14437500",
+		EstimatedSettlePrice: "10981.74168236",
+		LastFundingRate:      "0.00071003",
+		InterestRate:         "0.00010000",
+		NextFundingTime:      1596096000000,
+		Time:                 1596094042000,
+	}
+	r.Equal(e, res[0])
+}
+
+func (s *premiumIndexServiceTestSuite) TestGetPremiumIndexOfSymbol() {
+	data := []byte(`{
+        "symbol": "BTCUSD_PERP",
+        "pair": "BTCUSD",
+        "markPrice": "11029.69574559",
+        "indexPrice": "10979.14437500",
+        "estimatedSettlePrice": "10981.74168236",
+        "lastFundingRate": "0.00071003",
+        "interestRate": "0.00010000",
+        "nextFundingTime": 1596096000000,
+        "time": 1596094042000
+    }`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": symbol,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewPremiumIndexService().Symbol(symbol).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(symbol, res[0].Symbol)
+	r.Equal("11029.69574559", res[0].MarkPrice)
+}
+
+func (s *premiumIndexServiceTestSuite) TestGetFundingRate() {
+	data := []byte(`[
+        {
+            "symbol": "BTCUSD_PERP",
+            "fundingTime": 1596038400000,
+            "fundingRate": "-0.00300000"
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_PERP"
+	startTime := int64(1596038400000)
+	endTime := int64(1596038400001)
+	limit := 10
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    symbol,
+			"startTime": startTime,
+			"endTime":   endTime,
+			"limit":     limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewFundingRateService().Symbol(symbol).
+		StartTime(startTime).EndTime(endTime).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &FundingRate{
+		Symbol:      "BTCUSD_PERP",
+		FundingRate: "-0.00300000",
+		FundingTime: 1596038400000,
+	}
+	r.Equal(e, res[0])
+}
+
+func (s *premiumIndexServiceTestSuite) TestGetFundingRateInfo() {
+	data := []byte(`[
+        {
+            "symbol": "BLZUSD_PERP",
+            "adjustedFundingRateCap": "0.02500000",
+            "adjustedFundingRateFloor": "-0.02500000",
+            "fundingIntervalHours": 8,
+            "disclaimer": false
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	s.assertReq(func(r *request) {
+		e := newRequest()
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewFundingRateInfoService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	e := &FundingRateInfo{
+		Symbol:                   "BLZUSD_PERP",
+		AdjustedFundingRateCap:   "0.02500000",
+		AdjustedFundingRateFloor: "-0.02500000",
+		FundingIntervalHours:     8,
+	}
+	r.Equal(e, res[0])
+}
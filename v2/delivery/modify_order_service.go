@@ -0,0 +1,280 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// ModifyOrderService modify the price or quantity of a limit order
+type ModifyOrderService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	side              SideType
+	quantity          string
+	price             *string
+	priceMatch        *PriceMatchType
+}
+
+// Symbol set symbol
+func (s *ModifyOrderService) Symbol(symbol string) *ModifyOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *ModifyOrderService) OrderID(orderID int64) *ModifyOrderService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *ModifyOrderService) OrigClientOrderID(origClientOrderID string) *ModifyOrderService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// Side set side
+func (s *ModifyOrderService) Side(side SideType) *ModifyOrderService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity
+func (s *ModifyOrderService) Quantity(quantity string) *ModifyOrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *ModifyOrderService) Price(price string) *ModifyOrderService {
+	s.price = &price
+	return s
+}
+
+// PriceMatch set priceMatch, it can't be passed together with price
+func (s *ModifyOrderService) PriceMatch(priceMatch PriceMatchType) *ModifyOrderService {
+	s.priceMatch = &priceMatch
+	return s
+}
+
+func (s *ModifyOrderService) buildParams() params {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.priceMatch != nil {
+		m["priceMatch"] = *s.priceMatch
+	}
+	return m
+}
+
+// Do send request
+func (s *ModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/order",
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.buildParams())
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(Order)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ModifyBatchOrdersService modify multiple orders, at most 5 orders per request
+type ModifyBatchOrdersService struct {
+	c      *Client
+	orders []*ModifyOrderService
+}
+
+// OrderList set the orders to modify
+func (s *ModifyBatchOrdersService) OrderList(orders []*ModifyOrderService) *ModifyBatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// ModifyBatchOrdersResponse contains the response from ModifyBatchOrders operation
+type ModifyBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were modified successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was modified successfully OR a non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// Do send request
+func (s *ModifyBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *ModifyBatchOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodPut,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	orders := make([]params, 0, len(s.orders))
+	for _, order := range s.orders {
+		orders = append(orders, order.buildParams())
+	}
+	b, err := json.Marshal(orders)
+	if err != nil {
+		return nil, err
+	}
+	r.setFormParam("batchOrders", string(b))
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages := make([]*json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	res = &ModifyBatchOrdersResponse{N: len(rawMessages), Errors: make([]error, len(rawMessages))}
+	for i, j := range rawMessages {
+		// check if response is an API error
+		e := new(common.APIError)
+		if err := json.Unmarshal(*j, e); err != nil {
+			return nil, err
+		}
+		if e.IsValid() {
+			res.Errors[i] = e
+			continue
+		}
+		o := new(Order)
+		if err := json.Unmarshal(*j, o); err != nil {
+			return nil, err
+		}
+		res.Orders = append(res.Orders, o)
+	}
+	return res, nil
+}
+
+// GetOrderModifyHistoryService get the modifications of an order
+type GetOrderModifyHistoryService struct {
+	c                 *Client
+	symbol            string
+	orderID           *int64
+	origClientOrderID *string
+	startTime         *int64
+	endTime           *int64
+	limit             *int
+}
+
+// Symbol set symbol
+func (s *GetOrderModifyHistoryService) Symbol(symbol string) *GetOrderModifyHistoryService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderID set orderID
+func (s *GetOrderModifyHistoryService) OrderID(orderID int64) *GetOrderModifyHistoryService {
+	s.orderID = &orderID
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *GetOrderModifyHistoryService) OrigClientOrderID(origClientOrderID string) *GetOrderModifyHistoryService {
+	s.origClientOrderID = &origClientOrderID
+	return s
+}
+
+// StartTime set startTime
+func (s *GetOrderModifyHistoryService) StartTime(startTime int64) *GetOrderModifyHistoryService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *GetOrderModifyHistoryService) EndTime(endTime int64) *GetOrderModifyHistoryService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set limit
+func (s *GetOrderModifyHistoryService) Limit(limit int) *GetOrderModifyHistoryService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *GetOrderModifyHistoryService) Do(ctx context.Context, opts ...RequestOption) (res []*OrderAmendment, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/orderAmendment",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	if s.origClientOrderID != nil {
+		r.setParam("origClientOrderId", *s.origClientOrderID)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	res = make([]*OrderAmendment, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OrderAmendment{}, err
+	}
+	return res, nil
+}
+
+// OrderAmendment define a modification of an order
+type OrderAmendment struct {
+	AmendmentID   int64           `json:"amendmentId"`
+	Symbol        string          `json:"symbol"`
+	Pair          string          `json:"pair"`
+	OrderID       int64           `json:"orderId"`
+	ClientOrderID string          `json:"clientOrderId"`
+	Time          int64           `json:"time"`
+	Amendment     AmendmentDetail `json:"amendment"`
+}
+
+// AmendmentDetail define the price and quantity of an order before and after a modification,
+// Count is the number of modifications of the order
+type AmendmentDetail struct {
+	Price   AmendmentValue `json:"price"`
+	OrigQty AmendmentValue `json:"origQty"`
+	Count   int64          `json:"count"`
+}
+
+// AmendmentValue define a value before and after a modification
+type AmendmentValue struct {
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
@@ -0,0 +1,164 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type modifyOrderServiceTestSuite struct {
+	baseOrderTestSuite
+}
+
+func TestModifyOrderService(t *testing.T) {
+	suite.Run(t, new(modifyOrderServiceTestSuite))
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSD_PERP",
+		"pair": "BTCUSD",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"priceMatch": "QUEUE",
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":            "BTCUSD_PERP",
+			"origClientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"side":              SideTypeBuy,
+			"quantity":          "1",
+			"priceMatch":        PriceMatchTypeQueue,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").OrigClientOrderID("LJ9R4QZDihCaS8UAOOLpgW").
+		Side(SideTypeBuy).Quantity("1").PriceMatch(PriceMatchTypeQueue).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(&Order{
+		AvgPrice:         "0.0",
+		ClientOrderID:    "LJ9R4QZDihCaS8UAOOLpgW",
+		CumBase:          "0",
+		ExecutedQuantity: "0",
+		OrderID:          20072994037,
+		OrigQuantity:     "1",
+		OrigType:         OrderTypeLimit,
+		Price:            "30005",
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeLong,
+		Status:           OrderStatusTypeNew,
+		StopPrice:        "0",
+		Symbol:           "BTCUSD_PERP",
+		Pair:             "BTCUSD",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		UpdateTime:       1629182711600,
+		WorkingType:      WorkingTypeContractPrice,
+		PriceMatch:       PriceMatchTypeQueue,
+	}, res)
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"code": -4028,
+			"msg": "Price or quantity not changed."
+		},
+		{
+			"orderId": 42042724,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"status": "NEW",
+			"clientOrderId": "myOrder2",
+			"price": "100",
+			"origQty": "2",
+			"side": "SELL",
+			"updateTime": 1733117453012
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"orderId":42042723,"price":"99","quantity":"1","side":"BUY","symbol":"BTCUSD_PERP"},` +
+				`{"origClientOrderId":"myOrder2","price":"100","quantity":"2","side":"SELL","symbol":"BTCUSD_PERP"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrderService{
+		s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").OrderID(42042723).Side(SideTypeBuy).
+			Quantity("1").Price("99"),
+		s.client.NewModifyOrderService().Symbol("BTCUSD_PERP").OrigClientOrderID("myOrder2").Side(SideTypeSell).
+			Quantity("2").Price("100"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	r.Equal("myOrder2", res.Orders[0].ClientOrderID)
+	r.Nil(res.Errors[1])
+	apiErr, ok := common.AsAPIError(res.Errors[0])
+	r.True(ok)
+	r.Equal(int64(-4028), apiErr.Code)
+}
+
+func (s *modifyOrderServiceTestSuite) TestGetOrderModifyHistory() {
+	data := []byte(`[
+		{
+			"amendmentId": 5363,
+			"symbol": "BTCUSD_PERP",
+			"pair": "BTCUSD",
+			"orderId": 20072994037,
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"time": 1629184560899,
+			"amendment": {
+				"price": {"before": "30004", "after": "30003.2"},
+				"origQty": {"before": "1", "after": "2"},
+				"count": 1
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":            "BTCUSD_PERP",
+			"origClientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOrderModifyHistoryService().Symbol("BTCUSD_PERP").
+		OrigClientOrderID("LJ9R4QZDihCaS8UAOOLpgW").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(int64(5363), res[0].AmendmentID)
+	r.Equal(AmendmentValue{Before: "1", After: "2"}, res[0].Amendment.OrigQty)
+	r.Equal(int64(1), res[0].Amendment.Count)
+}
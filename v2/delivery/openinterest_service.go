@@ -0,0 +1,133 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// GetOpenInterestService get present open interest of a specific symbol.
+type GetOpenInterestService struct {
+	c      *Client
+	symbol string
+}
+
+// Symbol set symbol
+func (s *GetOpenInterestService) Symbol(symbol string) *GetOpenInterestService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *GetOpenInterestService) Do(ctx context.Context, opts ...RequestOption) (res *OpenInterest, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/dapi/v1/openInterest",
+	}
+	r.setParam("symbol", s.symbol)
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(OpenInterest)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// OpenInterest define open interest info, the open interest is in contracts
+type OpenInterest struct {
+	Symbol       string `json:"symbol"`
+	Pair         string `json:"pair"`
+	OpenInterest string `json:"openInterest"`
+	ContractType string `json:"contractType"`
+	Time         int64  `json:"time"`
+}
+
+// OpenInterestStatisticsService list open interest history of a contract type of a pair.
+type OpenInterestStatisticsService struct {
+	c            *Client
+	pair         string
+	contractType string
+	period       string
+	limit        *int
+	startTime    *int64
+	endTime      *int64
+}
+
+// Pair set pair
+func (s *OpenInterestStatisticsService) Pair(pair string) *OpenInterestStatisticsService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType, ALL, PERPETUAL, CURRENT_QUARTER or NEXT_QUARTER
+func (s *OpenInterestStatisticsService) ContractType(contractType string) *OpenInterestStatisticsService {
+	s.contractType = contractType
+	return s
+}
+
+// Period set period interval
+func (s *OpenInterestStatisticsService) Period(period string) *OpenInterestStatisticsService {
+	s.period = period
+	return s
+}
+
+// Limit set limit
+func (s *OpenInterestStatisticsService) Limit(limit int) *OpenInterestStatisticsService {
+	s.limit = &limit
+	return s
+}
+
+// StartTime set startTime
+func (s *OpenInterestStatisticsService) StartTime(startTime int64) *OpenInterestStatisticsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *OpenInterestStatisticsService) EndTime(endTime int64) *OpenInterestStatisticsService {
+	s.endTime = &endTime
+	return s
+}
+
+// Do send request
+func (s *OpenInterestStatisticsService) Do(ctx context.Context, opts ...RequestOption) (res []*OpenInterestStatistic, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/futures/data/openInterestHist",
+	}
+	r.setParam("pair", s.pair)
+	r.setParam("contractType", s.contractType)
+	r.setParam("period", s.period)
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*OpenInterestStatistic{}, err
+	}
+	res = make([]*OpenInterestStatistic, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*OpenInterestStatistic{}, err
+	}
+	return res, nil
+}
+
+// OpenInterestStatistic define open interest statistic
+type OpenInterestStatistic struct {
+	Pair                 string `json:"pair"`
+	ContractType         string `json:"contractType"`
+	SumOpenInterest      string `json:"sumOpenInterest"`
+	SumOpenInterestValue string `json:"sumOpenInterestValue"`
+	Timestamp            int64  `json:"timestamp"`
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"net/http"
 	"strconv"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
@@ -64,6 +66,11 @@ func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
 	return s
 }
 
+// QuantityDecimal set quantity
+func (s *CreateOrderService) QuantityDecimal(quantity common.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
 // ReduceOnly set reduceOnly
 func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
 	reduceOnlyStr := strconv.FormatBool(reduceOnly)
@@ -77,6 +84,11 @@ func (s *CreateOrderService) Price(price string) *CreateOrderService {
 	return s
 }
 
+// PriceDecimal set price
+func (s *CreateOrderService) PriceDecimal(price common.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
 // NewClientOrderID set newClientOrderID
 func (s *CreateOrderService) NewClientOrderID(newClientOrderID string) *CreateOrderService {
 	s.newClientOrderID = &newClientOrderID
@@ -89,6 +101,11 @@ func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
 	return s
 }
 
+// StopPriceDecimal set stopPrice
+func (s *CreateOrderService) StopPriceDecimal(stopPrice common.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // WorkingType set workingType
 func (s *CreateOrderService) WorkingType(workingType WorkingType) *CreateOrderService {
 	s.workingType = &workingType
@@ -101,12 +118,22 @@ func (s *CreateOrderService) ActivationPrice(activationPrice string) *CreateOrde
 	return s
 }
 
+// ActivationPriceDecimal set activationPrice
+func (s *CreateOrderService) ActivationPriceDecimal(activationPrice common.Decimal) *CreateOrderService {
+	return s.ActivationPrice(activationPrice.String())
+}
+
 // CallbackRate set callbackRate
 func (s *CreateOrderService) CallbackRate(callbackRate string) *CreateOrderService {
 	s.callbackRate = &callbackRate
 	return s
 }
 
+// CallbackRateDecimal set callbackRate
+func (s *CreateOrderService) CallbackRateDecimal(callbackRate common.Decimal) *CreateOrderService {
+	return s.CallbackRate(callbackRate.String())
+}
+
 // PriceProtect set priceProtect
 func (s *CreateOrderService) PriceProtect(priceProtect bool) *CreateOrderService {
 	priceProtectStr := strconv.FormatBool(priceProtect)
@@ -221,6 +248,7 @@ type CreateOrderResponse struct {
 	UpdateTime       int64            `json:"updateTime"`
 	WorkingType      WorkingType      `json:"workingType"`
 	PriceProtect     bool             `json:"priceProtect"`
+	PriceMatch       PriceMatchType   `json:"priceMatch"`
 }
 
 // ListOpenOrdersService list opened orders
@@ -345,6 +373,7 @@ type Order struct {
 	UpdateTime       int64            `json:"updateTime"`
 	WorkingType      WorkingType      `json:"workingType"`
 	PriceProtect     bool             `json:"priceProtect"`
+	PriceMatch       PriceMatchType   `json:"priceMatch"`
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -538,6 +567,95 @@ func (s *CancelAllOpenOrdersService) Do(ctx context.Context, opts ...RequestOpti
 	return nil
 }
 
+// CancelMultiplesOrdersService cancel a list of orders
+type CancelMultiplesOrdersService struct {
+	c                     *Client
+	symbol                string
+	orderIDList           []int64
+	origClientOrderIDList []string
+}
+
+// Symbol set symbol
+func (s *CancelMultiplesOrdersService) Symbol(symbol string) *CancelMultiplesOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderIDList set orderIdList
+func (s *CancelMultiplesOrdersService) OrderIDList(orderIDList []int64) *CancelMultiplesOrdersService {
+	s.orderIDList = orderIDList
+	return s
+}
+
+// OrigClientOrderIDList set origClientOrderIdList
+func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDList []string) *CancelMultiplesOrdersService {
+	s.origClientOrderIDList = origClientOrderIDList
+	return s
+}
+
+// CancelMultipleOrdersResponse contains the response from CancelMultipleOrders operation
+type CancelMultipleOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were canceled successfully which can have a length between 0 and N
+	Orders []*CancelOrderResponse
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was canceled successfully OR a non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// Do send request
+func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelMultipleOrdersResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/dapi/v1/batchOrders",
+		secType:  secTypeSigned,
+	}
+	r.setFormParam("symbol", s.symbol)
+	if s.orderIDList != nil {
+		b, err := json.Marshal(s.orderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("orderIdList", string(b))
+	}
+	if s.origClientOrderIDList != nil {
+		b, err := json.Marshal(s.origClientOrderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("origClientOrderIdList", string(b))
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	rawMessages := make([]*json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
+	if err != nil {
+		return nil, err
+	}
+	res = &CancelMultipleOrdersResponse{N: len(rawMessages), Errors: make([]error, len(rawMessages))}
+	for i, j := range rawMessages {
+		// check if response is an API error
+		e := new(common.APIError)
+		if err := json.Unmarshal(*j, e); err != nil {
+			return nil, err
+		}
+		if e.IsValid() {
+			res.Errors[i] = e
+			continue
+		}
+		o := new(CancelOrderResponse)
+		if err := json.Unmarshal(*j, o); err != nil {
+			return nil, err
+		}
+		res.Orders = append(res.Orders, o)
+	}
+	return res, nil
+}
+
 // ListLiquidationOrdersService list liquidation orders
 type ListLiquidationOrdersService struct {
 	c         *Client
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type baseOrderTestSuite struct {
@@ -509,6 +511,86 @@ func (s *orderServiceTestSuite) assertCancelOrderResponseEqual(e, a *CancelOrder
 	r.Equal(e.PriceProtect, a.PriceProtect, "PriceProtect")
 }
 
+func (s *orderServiceTestSuite) TestCancelMultipleOrders() {
+	data := []byte(`[
+		{
+			"avgPrice": "0.0",
+			"clientOrderId": "myOrder1",
+			"cumQty": "0",
+			"cumBase": "0",
+			"executedQty": "0",
+			"orderId": 283194212,
+			"origQty": "11",
+			"origType": "LIMIT",
+			"price": "8301",
+			"reduceOnly": false,
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"status": "CANCELED",
+			"stopPrice": "0",
+			"closePosition": false,
+			"symbol": "BTCUSD_200925",
+			"pair": "BTCUSD",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"updateTime": 1571110484038,
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false
+		},
+		{
+			"code": -2011,
+			"msg": "Unknown order sent."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200925"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                symbol,
+			"orderIdList":           "[283194212,283194213]",
+			"origClientOrderIdList": `["myOrder1","myOrder2"]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelMultipleOrdersService().Symbol(symbol).
+		OrderIDList([]int64{283194212, 283194213}).
+		OrigClientOrderIDList([]string{"myOrder1", "myOrder2"}).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	s.assertCancelOrderResponseEqual(&CancelOrderResponse{
+		AvgPrice:         "0.0",
+		ClientOrderID:    "myOrder1",
+		CumQuantity:      "0",
+		CumBase:          "0",
+		ExecutedQuantity: "0",
+		OrderID:          283194212,
+		OrigQuantity:     "11",
+		OrigType:         OrderTypeLimit,
+		Price:            "8301",
+		ReduceOnly:       false,
+		Side:             SideTypeBuy,
+		PositionSide:     PositionSideTypeBoth,
+		Status:           OrderStatusTypeCanceled,
+		StopPrice:        "0",
+		Symbol:           symbol,
+		Pair:             "BTCUSD",
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		UpdateTime:       1571110484038,
+		WorkingType:      WorkingTypeContractPrice,
+	}, res.Orders[0])
+	r.NoError(res.Errors[0])
+	apiErr, ok := res.Errors[1].(*common.APIError)
+	r.True(ok)
+	r.Equal(int64(-2011), apiErr.Code)
+}
+
 func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
 	data := []byte(`{
 		"code": "200", 
@@ -3,6 +3,9 @@ package delivery
 import (
 	"context"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // PingService ping server
@@ -58,3 +61,17 @@ func (s *SetServerTimeService) Do(ctx context.Context, opts ...RequestOption) (t
 	s.c.TimeOffset = timeOffset
 	return timeOffset, nil
 }
+
+// StartTimeSync sync the time offset with the server time now then every interval
+// in the background until ctx is done or c.TimeSync.Stop is called
+func (c *Client) StartTimeSync(ctx context.Context, interval time.Duration) error {
+	ts := common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+	ts.Interval = interval
+	if err := ts.Start(ctx); err != nil {
+		return err
+	}
+	c.TimeSync = ts
+	return nil
+}
@@ -0,0 +1,204 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+)
+
+const defaultSymbolRegistryInterval = time.Hour
+
+// SymbolChangeEvent define a change of a symbol between two loads of the exchange info,
+// Old is nil for an added symbol and New is nil for a removed one
+type SymbolChangeEvent struct {
+	Type   SymbolChangeType
+	Symbol string
+	Old    *Symbol
+	New    *Symbol
+}
+
+// SymbolRegistry keeps the symbols of the exchange info, loaded once and refreshed
+// every Interval. The returned symbols are shared and must not be modified.
+type SymbolRegistry struct {
+	// Interval between refreshes, default 1 hour
+	Interval time.Duration
+	// OnChange is called for each symbol added, removed, or whose status or
+	// filters changed since the previous load
+	OnChange func(event *SymbolChangeEvent)
+	// ErrHandler is called when a background refresh fails
+	ErrHandler func(err error)
+
+	c        *Client
+	mu       sync.RWMutex
+	symbols  map[string]*Symbol
+	loadedAt time.Time
+	cancel   context.CancelFunc
+}
+
+// Refresh load the exchange info and call OnChange for the changed symbols,
+// the first load does not emit events
+func (r *SymbolRegistry) Refresh(ctx context.Context) error {
+	info, err := r.c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	r.SetExchangeInfo(info)
+	return nil
+}
+
+// SetExchangeInfo replace the symbols by the ones of info, like Refresh
+func (r *SymbolRegistry) SetExchangeInfo(info *ExchangeInfo) {
+	symbols := make(map[string]*Symbol, len(info.Symbols))
+	for i := range info.Symbols {
+		symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+	}
+	r.mu.Lock()
+	old := r.symbols
+	r.symbols = symbols
+	r.loadedAt = time.Now()
+	r.mu.Unlock()
+	if old == nil || r.OnChange == nil {
+		return
+	}
+	for _, e := range diffSymbols(old, symbols) {
+		r.OnChange(e)
+	}
+}
+
+func diffSymbols(old, new map[string]*Symbol) []*SymbolChangeEvent {
+	var events []*SymbolChangeEvent
+	for name, n := range new {
+		o, ok := old[name]
+		switch {
+		case !ok:
+			events = append(events, &SymbolChangeEvent{Type: SymbolChangeTypeAdded, Symbol: name, New: n})
+			continue
+		case o.ContractStatus != n.ContractStatus:
+			events = append(events, &SymbolChangeEvent{Type: SymbolChangeTypeStatus, Symbol: name, Old: o, New: n})
+		}
+		if !reflect.DeepEqual(o.Filters, n.Filters) {
+			events = append(events, &SymbolChangeEvent{Type: SymbolChangeTypeFilters, Symbol: name, Old: o, New: n})
+		}
+	}
+	for name, o := range old {
+		if _, ok := new[name]; !ok {
+			events = append(events, &SymbolChangeEvent{Type: SymbolChangeTypeRemoved, Symbol: name, Old: o})
+		}
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Symbol < events[j].Symbol })
+	return events
+}
+
+// Loaded tells if the exchange info was loaded and when
+func (r *SymbolRegistry) Loaded() (time.Time, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.loadedAt, r.symbols != nil
+}
+
+// Symbol return the info of symbol
+func (r *SymbolRegistry) Symbol(symbol string) (*Symbol, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	s, ok := r.symbols[symbol]
+	return s, ok
+}
+
+// Symbols return all the symbols sorted by name
+func (r *SymbolRegistry) Symbols() []*Symbol {
+	r.mu.RLock()
+	symbols := make([]*Symbol, 0, len(r.symbols))
+	for _, s := range r.symbols {
+		symbols = append(symbols, s)
+	}
+	r.mu.RUnlock()
+	sort.Slice(symbols, func(i, j int) bool { return symbols[i].Symbol < symbols[j].Symbol })
+	return symbols
+}
+
+// Status return the contract status of symbol
+func (r *SymbolRegistry) Status(symbol string) (SymbolStatusType, bool) {
+	s, ok := r.Symbol(symbol)
+	if !ok {
+		return "", false
+	}
+	return SymbolStatusType(s.ContractStatus), true
+}
+
+// ContractType return the contract type of symbol, e.g. PERPETUAL or CURRENT_QUARTER
+func (r *SymbolRegistry) ContractType(symbol string) (string, bool) {
+	s, ok := r.Symbol(symbol)
+	if !ok {
+		return "", false
+	}
+	return s.ContractType, true
+}
+
+// DeliveryTime return the delivery time of symbol
+func (r *SymbolRegistry) DeliveryTime(symbol string) (time.Time, bool) {
+	s, ok := r.Symbol(symbol)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.UnixMilli(s.DeliveryDate), true
+}
+
+// MarginAsset return the margin asset of symbol
+func (r *SymbolRegistry) MarginAsset(symbol string) (string, bool) {
+	s, ok := r.Symbol(symbol)
+	if !ok {
+		return "", false
+	}
+	return s.MarginAsset, true
+}
+
+// Start load the exchange info then refresh it every Interval in the background
+// until Stop is called or ctx is done
+func (r *SymbolRegistry) Start(ctx context.Context) error {
+	r.mu.Lock()
+	if r.cancel != nil {
+		r.mu.Unlock()
+		return errors.New("symbol registry already started")
+	}
+	ctx, r.cancel = context.WithCancel(ctx)
+	r.mu.Unlock()
+	if err := r.Refresh(ctx); err != nil {
+		r.Stop()
+		return err
+	}
+	go r.run(ctx)
+	return nil
+}
+
+// Stop stop the background refresh
+func (r *SymbolRegistry) Stop() {
+	r.mu.Lock()
+	cancel := r.cancel
+	r.cancel = nil
+	r.mu.Unlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+func (r *SymbolRegistry) run(ctx context.Context) {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = defaultSymbolRegistryInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.Refresh(ctx); err != nil && ctx.Err() == nil && r.ErrHandler != nil {
+				r.ErrHandler(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package delivery
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolRegistryTestSuite struct {
+	baseTestSuite
+}
+
+func TestSymbolRegistry(t *testing.T) {
+	suite.Run(t, new(symbolRegistryTestSuite))
+}
+
+func (s *symbolRegistryTestSuite) TestChangeEvents() {
+	registry := s.client.NewSymbolRegistry()
+	var events []*SymbolChangeEvent
+	registry.OnChange = func(event *SymbolChangeEvent) {
+		events = append(events, event)
+	}
+	load := func(data string) {
+		info := new(ExchangeInfo)
+		s.r().NoError(json.Unmarshal([]byte(data), info))
+		registry.SetExchangeInfo(info)
+	}
+	load(`{"symbols": [{"symbol": "BTCUSD_PERP", "contractStatus": "TRADING", "contractType": "PERPETUAL", "marginAsset": "BTC"}]}`)
+	load(`{"symbols": [{"symbol": "BTCUSD_PERP", "contractStatus": "PENDING_TRADING", "contractType": "PERPETUAL", "marginAsset": "BTC"}]}`)
+	s.r().Len(events, 1)
+	s.r().Equal(SymbolChangeTypeStatus, events[0].Type)
+	s.r().Equal("TRADING", events[0].Old.ContractStatus)
+	s.r().Equal("PENDING_TRADING", events[0].New.ContractStatus)
+
+	status, ok := registry.Status("BTCUSD_PERP")
+	s.r().True(ok)
+	s.r().Equal(SymbolStatusType("PENDING_TRADING"), status)
+	contractType, ok := registry.ContractType("BTCUSD_PERP")
+	s.r().True(ok)
+	s.r().Equal("PERPETUAL", contractType)
+	marginAsset, ok := registry.MarginAsset("BTCUSD_PERP")
+	s.r().True(ok)
+	s.r().Equal("BTC", marginAsset)
+}
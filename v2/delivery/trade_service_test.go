@@ -0,0 +1,145 @@
+package delivery
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type tradeServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestTradeService(t *testing.T) {
+	suite.Run(t, new(tradeServiceTestSuite))
+}
+
+func (s *tradeServiceTestSuite) TestAggregateTrades() {
+	data := []byte(`[
+        {
+            "a": 416690,
+            "p": "9642.4",
+            "q": "3",
+            "f": 595259,
+            "l": 595259,
+            "T": 1591250548649,
+            "m": false
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200626"
+	fromID := int64(1)
+	startTime := int64(1591250548649)
+	endTime := int64(1591250548650)
+	limit := 1
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol":    symbol,
+			"fromId":    fromID,
+			"startTime": startTime,
+			"endTime":   endTime,
+			"limit":     limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	aggTrades, err := s.client.NewAggTradesService().Symbol(symbol).
+		FromID(fromID).StartTime(startTime).EndTime(endTime).Limit(limit).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(aggTrades, 1)
+	e := &AggTrade{
+		AggTradeID:   416690,
+		Price:        "9642.4",
+		Quantity:     "3",
+		FirstTradeID: 595259,
+		LastTradeID:  595259,
+		Timestamp:    1591250548649,
+		IsBuyerMaker: false,
+	}
+	r.Equal(e, aggTrades[0])
+}
+
+func (s *tradeServiceTestSuite) TestRecentTrades() {
+	data := []byte(`[
+        {
+            "id": 28457,
+            "price": "9635.0",
+            "qty": "1",
+            "baseQty": "0.01037883",
+            "time": 1591250192508,
+            "isBuyerMaker": true
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200626"
+	limit := 3
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": symbol,
+			"limit":  limit,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	trades, err := s.client.NewRecentTradesService().Symbol(symbol).Limit(limit).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(trades, 1)
+	e := &Trade{
+		ID:           28457,
+		Price:        "9635.0",
+		Quantity:     "1",
+		BaseQuantity: "0.01037883",
+		Time:         1591250192508,
+		IsBuyerMaker: true,
+	}
+	r.Equal(e, trades[0])
+}
+
+func (s *tradeServiceTestSuite) TestHistoricalTrades() {
+	data := []byte(`[
+        {
+            "id": 595103,
+            "price": "9642.2",
+            "qty": "1",
+            "baseQty": "0.01037107",
+            "time": 1591250192508,
+            "isBuyerMaker": false
+        }
+    ]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSD_200626"
+	limit := 3
+	fromID := int64(595103)
+	s.assertReq(func(r *request) {
+		e := newRequest().setParams(params{
+			"symbol": symbol,
+			"limit":  limit,
+			"fromId": fromID,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	trades, err := s.client.NewHistoricalTradesService().Symbol(symbol).
+		Limit(limit).FromID(fromID).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(trades, 1)
+	e := &Trade{
+		ID:           595103,
+		Price:        "9642.2",
+		Quantity:     "1",
+		BaseQuantity: "0.01037107",
+		Time:         1591250192508,
+		IsBuyerMaker: false,
+	}
+	r.Equal(e, trades[0])
+}
@@ -0,0 +1,36 @@
+package delivery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// UserStream define a managed user data stream, it keeps the listen key alive
+// and reconnects with a new listen key when it expires or the connection is lost
+type UserStream = common.UserStream[*WsUserDataEvent]
+
+// NewUserStream init a managed user data stream of the COIN-M futures account
+func (c *Client) NewUserStream() *UserStream {
+	return common.NewUserStream(common.UserStreamFuncs[*WsUserDataEvent]{
+		Start: func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		Keepalive: func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Close: func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		Serve: func(listenKey string, handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			// WebsocketReconnect is left out, a dropped connection must end doneC
+			// so that the stream reconnects with a listen key and reports the gap
+			cfg := &WsConfig{Endpoint: fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey), Proxy: getWsProxyUrl()}
+			return wsUserDataServe(cfg, handler, errHandler)
+		},
+		Expired: func(event *WsUserDataEvent) bool {
+			return event.Event == UserDataEventTypeListenKeyExpired
+		},
+	})
+}
@@ -0,0 +1,159 @@
+package delivery
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsDiffDepthServeChan serve the diff depth stream of symbol like WsDiffDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsDiffDepthServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsDiffDepthServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsKlineServeChan serve the kline stream of symbol like WsKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsKlineServeChan(ctx context.Context, symbol string, interval string, opts ...common.ChanOption) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsAggTradeServeChan serve the aggregate trade stream of symbol like WsAggTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAggTradeServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsAggTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsAggTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAggTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsMarkPriceServeChan serve the mark price stream of symbol like WsMarkPriceServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMarkPriceServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsMarkPriceEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarkPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsBookTickerServeChan serve the book ticker stream of symbol like WsBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsBookTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsBookTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsUserDataServeChan serve the user data stream of listenKey like WsUserDataServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsUserDataServeChan(ctx context.Context, listenKey string, opts ...common.ChanOption) (<-chan *WsUserDataEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
+
+// WsIndexPriceServeChan serve the index price stream of symbol like WsIndexPriceServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsIndexPriceServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsIndexPriceEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsIndexPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsIndexPriceServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsPairMarkPriceServeChan serve the pair mark price stream like WsPairMarkPriceServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsPairMarkPriceServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsPairMarkPriceEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsPairMarkPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsPairMarkPriceServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsContinuousKlineServeChan serve the continuous kline stream of pair like WsContinuousKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsContinuousKlineServeChan(ctx context.Context, pair string, contractType string, interval string, opts ...common.ChanOption) (<-chan *WsContinuousKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsContinuousKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsContinuousKlineServe(pair, contractType, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsIndexPriceKlineServeChan serve the index price kline stream of pair like WsIndexPriceKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsIndexPriceKlineServeChan(ctx context.Context, pair string, interval string, opts ...common.ChanOption) (<-chan *WsIndexPriceKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsIndexPriceKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsIndexPriceKlineServe(pair, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsMarkPriceKlineServeChan serve the mark price kline stream of symbol like WsMarkPriceKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMarkPriceKlineServeChan(ctx context.Context, symbol string, interval string, opts ...common.ChanOption) (<-chan *WsMarkPriceKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarkPriceKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsMiniMarketTickerServeChan serve the mini market ticker stream of symbol like WsMiniMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMiniMarketTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsMiniMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMiniMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMiniMarketTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllMiniMarketTickerServeChan serve the all mini market ticker stream like WsAllMiniMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllMiniMarketTickerServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsAllMiniMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsAllMiniMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllMiniMarketTickerServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsMarketTickerServeChan serve the market ticker stream of symbol like WsMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMarketTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarketTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllMarketTickerServeChan serve the all market ticker stream like WsAllMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllMarketTickerServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsAllMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsAllMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarketTickerServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsAllBookTickerServeChan serve the all book ticker stream like WsAllBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllBookTickerServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllBookTickerServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsLiquidationOrderServeChan serve the liquidation order stream of symbol like WsLiquidationOrderServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsLiquidationOrderServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsLiquidationOrderEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsLiquidationOrderEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsLiquidationOrderServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllLiquidationOrderServeChan serve the all liquidation order stream like WsAllLiquidationOrderServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllLiquidationOrderServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan *WsLiquidationOrderEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsLiquidationOrderEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllLiquidationOrderServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsPartialDepthServeChan serve the partial depth stream of symbol like WsPartialDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsPartialDepthServeChan(ctx context.Context, symbol string, levels int, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, opts...)
+}
@@ -0,0 +1,54 @@
+package delivery
+
+import (
+	"context"
+	"errors"
+)
+
+func (s *websocketServiceTestSuite) TestIndexPriceServeChan() {
+	s.mockWsServe([]byte(`{
+		"e": "indexPriceUpdate",
+		"E": 1591261236000,
+		"i": "BTCUSD",
+		"p": "9636.57860000"
+	}`), errors.New("fake error"))
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs, err := WsIndexPriceServeChan(ctx, "BTCUSD")
+	s.r().NoError(err)
+	event := <-events
+	s.Equal("BTCUSD", event.Pair)
+	s.Equal("9636.57860000", event.IndexPrice)
+	s.EqualError(<-errs, "fake error")
+	cancel()
+	_, ok := <-events
+	s.False(ok)
+	_, ok = <-errs
+	s.False(ok)
+}
+
+func (s *websocketServiceTestSuite) TestPairMarkPriceServeChan() {
+	s.mockWsServe([]byte(`[
+		{
+			"e": "markPriceUpdate",
+			"E": 1596095725000,
+			"s": "BTCUSD_201225",
+			"p": "10934.62615417",
+			"P": "10962.17178236",
+			"r": "",
+			"T": 0
+		}
+	]`), nil)
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _, err := WsPairMarkPriceServeChan(ctx)
+	s.r().NoError(err)
+	event := <-events
+	s.Len(event, 1)
+	s.Equal("BTCUSD_201225", event[0].Symbol)
+	cancel()
+	_, ok := <-events
+	s.False(ok)
+}
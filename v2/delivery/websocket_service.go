@@ -20,6 +20,8 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect enables reconnecting dropped streams with the given policy, nil disables it
+	WebsocketReconnect *WsReconnectConfig
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 	ProxyUrl   = ""
@@ -772,6 +774,7 @@ type WsOrderTradeUpdate struct {
 	ActivationPrice      string             `json:"AP"`
 	CallbackRate         string             `json:"cr"`
 	IsProtected          bool               `json:"pP"`
+	PriceMatch           PriceMatchType     `json:"pm"`
 }
 
 // WsAccountConfigUpdate define account config update
@@ -785,8 +788,11 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)), handler, errHandler)
+}
+
+// wsUserDataServe serve the user data stream with cfg
+func wsUserDataServe(cfg *WsConfig, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -1484,6 +1484,78 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdateAmendment() {
+	data := []byte(`{
+		"e":"ORDER_TRADE_UPDATE",
+		"E":1629184560899,
+		"T":1629184560898,
+		"i":"SfsR",
+		"o":{
+		  "s":"BTCUSD_PERP",
+		  "c":"LJ9R4QZDihCaS8UAOOLpgW",
+		  "S":"BUY",
+		  "o":"LIMIT",
+		  "f":"GTC",
+		  "q":"2",
+		  "p":"30003.2",
+		  "ap":"0",
+		  "sp":"0",
+		  "x":"AMENDMENT",
+		  "X":"NEW",
+		  "i":20072994037,
+		  "l":"0",
+		  "z":"0",
+		  "L":"0",
+		  "ma":"BTC",
+		  "T":1629184560898,
+		  "t":0,
+		  "rp":"0",
+		  "b":"0.00666",
+		  "a":"0",
+		  "m":false,
+		  "R":false,
+		  "wt":"CONTRACT_PRICE",
+		  "ot":"LIMIT",
+		  "ps":"LONG",
+		  "cp":false,
+		  "pP":false,
+		  "pm":"QUEUE"
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "ORDER_TRADE_UPDATE",
+		Time:            1629184560899,
+		TransactionTime: 1629184560898,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:               "BTCUSD_PERP",
+			ClientOrderID:        "LJ9R4QZDihCaS8UAOOLpgW",
+			Side:                 SideTypeBuy,
+			Type:                 OrderTypeLimit,
+			TimeInForce:          TimeInForceTypeGTC,
+			OriginalQty:          "2",
+			OriginalPrice:        "30003.2",
+			AveragePrice:         "0",
+			StopPrice:            "0",
+			ExecutionType:        OrderExecutionTypeAmendment,
+			Status:               OrderStatusTypeNew,
+			ID:                   20072994037,
+			LastFilledQty:        "0",
+			AccumulatedFilledQty: "0",
+			LastFilledPrice:      "0",
+			MarginAsset:          "BTC",
+			TradeTime:            1629184560898,
+			RealizedPnL:          "0",
+			BidsNotional:         "0.00666",
+			AsksNotional:         "0",
+			WorkingType:          WorkingTypeContractPrice,
+			OriginalType:         OrderTypeLimit,
+			PositionSide:         PositionSideTypeLong,
+			PriceMatch:           PriceMatchTypeQueue,
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) assertUserDataEvent(e, a *WsUserDataEvent) {
 	r := s.r()
 	r.Equal(e.Event, a.Event, "Event")
@@ -1559,4 +1631,5 @@ func (s *websocketServiceTestSuite) assertOrderTradeUpdate(e, a WsOrderTradeUpda
 	r.Equal(e.ActivationPrice, a.ActivationPrice, "ActivationPrice")
 	r.Equal(e.CallbackRate, a.CallbackRate, "CallbackRate")
 	r.Equal(e.RealizedPnL, a.RealizedPnL, "RealizedPnL")
+	r.Equal(e.PriceMatch, a.PriceMatch, "PriceMatch")
 }
@@ -3,6 +3,9 @@ package binance
 import (
 	"context"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListDepositsService fetches deposit history.
@@ -103,6 +106,30 @@ func (s *ListDepositsService) Do(ctx context.Context) (res []*Deposit, err error
 	return res, nil
 }
 
+// Iterate walk the deposits by windows of 90 days from startTime to endTime
+// (default now), or the deposits returned without time range
+func (s *ListDepositsService) Iterate() *common.Iterator[*Deposit] {
+	limit := 1000
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	var start, end int64
+	if s.startTime != nil {
+		start, end = *s.startTime, time.Now().UnixMilli()
+		if s.endTime != nil {
+			end = *s.endTime
+		}
+	}
+	return common.NewOffsetIterator(start, end, 90*24*time.Hour, limit,
+		func(ctx context.Context, start, end int64, offset int) ([]*Deposit, error) {
+			page := *s
+			if end > 0 {
+				page.StartTime(start).EndTime(end)
+			}
+			return page.Offset(offset).Limit(limit).Do(ctx)
+		})
+}
+
 // Deposit represents a single deposit entry.
 type Deposit struct {
 	Amount        string `json:"amount"`
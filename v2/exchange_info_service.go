@@ -73,6 +73,13 @@ type ExchangeInfo struct {
 	RateLimits      []RateLimit   `json:"rateLimits"`
 	ExchangeFilters []interface{} `json:"exchangeFilters"`
 	Symbols         []Symbol      `json:"symbols"`
+	Sors            []SOR         `json:"sors"`
+}
+
+// SOR define the symbols an order can be routed between by SOR
+type SOR struct {
+	BaseAsset string   `json:"baseAsset"`
+	Symbols   []string `json:"symbols"`
 }
 
 // RateLimit struct
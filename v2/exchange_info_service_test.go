@@ -99,6 +99,12 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 				}],
 				"permissions": ["SPOT","MARGIN"]
 			}
+		],
+		"sors": [
+			{
+				"baseAsset": "BTC",
+				"symbols": ["BTCUSDT", "BTCUSDC"]
+			}
 		]
 	}`)
 	s.mockDo(data, nil)
@@ -125,6 +131,7 @@ func (s *exchangeInfoServiceTestSuite) TestExchangeInfo() {
 			{RateLimitType: "ORDERS", Interval: "DAY", IntervalNum: 1, Limit: 100000},
 		},
 		ExchangeFilters: []interface{}{},
+		Sors:            []SOR{{BaseAsset: "BTC", Symbols: []string{"BTCUSDT", "BTCUSDC"}}},
 		Symbols: []Symbol{
 			{
 				Symbol:                 "ETHBTC",
@@ -232,6 +239,7 @@ func (s *exchangeInfoServiceTestSuite) assertExchangeInfoEqual(e, a *ExchangeInf
 	}
 
 	r.Equal(e.ExchangeFilters, a.ExchangeFilters, "ExchangeFilters")
+	r.Equal(e.Sors, a.Sors, "Sors")
 
 	for i, currentSymbol := range a.Symbols {
 		if a.Symbols[i].Symbol == e.Symbols[0].Symbol {
@@ -48,12 +48,18 @@ type SymbolStatusType string
 // SymbolFilterType define symbol filter type
 type SymbolFilterType string
 
+// SymbolChangeType define the type of a symbol change
+type SymbolChangeType string
+
 // SideEffectType define side effect type for orders
 type SideEffectType string
 
 // STPModeType define self trade prevention mode type
 type STPModeType string
 
+// PriceMatchType define price match mode of an order
+type PriceMatchType string
+
 // WorkingType define working type
 type WorkingType string
 
@@ -110,6 +116,7 @@ const (
 	OrderExecutionTypeCalculated  OrderExecutionType = "CALCULATED"
 	OrderExecutionTypeExpired     OrderExecutionType = "EXPIRED"
 	OrderExecutionTypeTrade       OrderExecutionType = "TRADE"
+	OrderExecutionTypeAmendment   OrderExecutionType = "AMENDMENT"
 
 	OrderStatusTypeNew             OrderStatusType = "NEW"
 	OrderStatusTypePartiallyFilled OrderStatusType = "PARTIALLY_FILLED"
@@ -141,6 +148,11 @@ const (
 	SymbolFilterTypeMaxNumAlgoOrders SymbolFilterType = "MAX_NUM_ALGO_ORDERS"
 	SymbolFilterTypeMinNotional      SymbolFilterType = "MIN_NOTIONAL"
 
+	SymbolChangeTypeAdded   SymbolChangeType = "ADDED"
+	SymbolChangeTypeRemoved SymbolChangeType = "REMOVED"
+	SymbolChangeTypeStatus  SymbolChangeType = "STATUS"
+	SymbolChangeTypeFilters SymbolChangeType = "FILTERS"
+
 	SideEffectTypeNoSideEffect SideEffectType = "NO_SIDE_EFFECT"
 	SideEffectTypeMarginBuy    SideEffectType = "MARGIN_BUY"
 	SideEffectTypeAutoRepay    SideEffectType = "AUTO_REPAY"
@@ -150,10 +162,22 @@ const (
 	STPModeTypeExpireMaker STPModeType = "EXPIRE_MAKER"
 	STPModeTypeExpireBoth  STPModeType = "EXPIRE_BOTH"
 
+	PriceMatchTypeNone       PriceMatchType = "NONE"
+	PriceMatchTypeOpponent   PriceMatchType = "OPPONENT"
+	PriceMatchTypeOpponent5  PriceMatchType = "OPPONENT_5"
+	PriceMatchTypeOpponent10 PriceMatchType = "OPPONENT_10"
+	PriceMatchTypeOpponent20 PriceMatchType = "OPPONENT_20"
+	PriceMatchTypeQueue      PriceMatchType = "QUEUE"
+	PriceMatchTypeQueue5     PriceMatchType = "QUEUE_5"
+	PriceMatchTypeQueue10    PriceMatchType = "QUEUE_10"
+	PriceMatchTypeQueue20    PriceMatchType = "QUEUE_20"
+
 	MarginTypeIsolated MarginType = "ISOLATED"
 	MarginTypeCrossed  MarginType = "CROSSED"
 
-	ContractTypePerpetual ContractType = "PERPETUAL"
+	ContractTypePerpetual      ContractType = "PERPETUAL"
+	ContractTypeCurrentQuarter ContractType = "CURRENT_QUARTER"
+	ContractTypeNextQuarter    ContractType = "NEXT_QUARTER"
 
 	UserDataEventTypeListenKeyExpired    UserDataEventType = "listenKeyExpired"
 	UserDataEventTypeMarginCall          UserDataEventType = "MARGIN_CALL"
@@ -255,7 +279,13 @@ type Client struct {
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
-	do         doFunc
+	// RateLimiter limits the requests before they are sent, nil disables it
+	RateLimiter *common.RateLimiter
+	// RetryPolicy sends again the requests failing with a transient error, nil disables it
+	RetryPolicy *common.RetryPolicy
+	// TimeSync replaces TimeOffset to sign requests when it is set
+	TimeSync *common.TimeSync
+	do       doFunc
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -279,7 +309,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -328,14 +358,63 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	return nil
 }
 
+// timeOffset return the offset to sign requests with
+func (c *Client) timeOffset() int64 {
+	if c.TimeSync != nil {
+		return c.TimeSync.Offset()
+	}
+	return c.TimeOffset
+}
+
+// resyncTime resync TimeSync when a signed request was rejected for its
+// timestamp, it tells if the request can be sent again
+func (c *Client) resyncTime(ctx context.Context, r *request, err error) bool {
+	if c.TimeSync == nil || r.secType != secTypeSigned || !common.IsTimestampOutOfRecvWindow(err) {
+		return false
+	}
+	_, err = c.TimeSync.Resync(ctx)
+	return err == nil
+}
+
+// callAPI send r, requests failing with a transient error are sent again
+// according to RetryPolicy when it is safe to do so, and signed requests
+// rejected for their timestamp are sent again once after resyncing TimeSync
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	resynced := false
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		data, statusCode, header, err = c.send(ctx, r, opts...)
+		if err == nil {
+			return data, header, err
+		}
+		// the options were applied to r by the first attempt
+		opts = nil
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			continue
+		}
+		if c.RetryPolicy == nil || !retryable(r) {
+			return data, header, err
+		}
+		delay, ok := c.RetryPolicy.Delay(attempt, err, statusCode, *header)
+		if !ok {
+			return data, header, err
+		}
+		c.debug("attempt %d failed, retry in %s: %s\n", attempt, delay, err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, &http.Header{}, err
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, r *request, opts ...RequestOption) (data []byte, statusCode int, header *http.Header, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
@@ -344,13 +423,26 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
+	if c.RateLimiter != nil {
+		if cost, ok := requestCost(r); ok {
+			if err = c.RateLimiter.Wait(ctx, cost); err != nil {
+				return []byte{}, 0, &http.Header{}, err
+			}
+		}
+	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
+	}
+	if c.RateLimiter != nil {
+		c.RateLimiter.Update(res.Header)
+	}
+	if r.usage != nil {
+		*r.usage = *common.ParseUsage(res.Header)
 	}
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -373,9 +465,11 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, &res.Header, apiErr
+		apiErr.StatusCode = res.StatusCode
+		apiErr.RetryAfter, _ = common.ParseRetryAfter(res.Header)
+		return nil, res.StatusCode, &res.Header, apiErr
 	}
-	return data, &res.Header, nil
+	return data, res.StatusCode, &res.Header, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -419,6 +513,11 @@ func (c *Client) NewKlinesService() *KlinesService {
 	return &KlinesService{c: c}
 }
 
+// NewKlineDownloadService init kline download service
+func (c *Client) NewKlineDownloadService() *KlineDownloadService {
+	return &KlineDownloadService{c: c}
+}
+
 // NewContinuousKlinesService init continuous klines service
 func (c *Client) NewContinuousKlinesService() *ContinuousKlinesService {
 	return &ContinuousKlinesService{c: c}
@@ -474,6 +573,21 @@ func (c *Client) NewCancelAllOpenOrdersService() *CancelAllOpenOrdersService {
 	return &CancelAllOpenOrdersService{c: c}
 }
 
+// NewModifyOrderService init modifying order service
+func (c *Client) NewModifyOrderService() *ModifyOrderService {
+	return &ModifyOrderService{c: c}
+}
+
+// NewModifyBatchOrdersService init modifying batch orders service
+func (c *Client) NewModifyBatchOrdersService() *ModifyBatchOrdersService {
+	return &ModifyBatchOrdersService{c: c}
+}
+
+// NewGetOrderModifyHistoryService init getting order modification history service
+func (c *Client) NewGetOrderModifyHistoryService() *GetOrderModifyHistoryService {
+	return &GetOrderModifyHistoryService{c: c}
+}
+
 // NewCancelMultipleOrdersService init cancel multiple orders service
 func (c *Client) NewCancelMultipleOrdersService() *CancelMultiplesOrdersService {
 	return &CancelMultiplesOrdersService{c: c}
@@ -549,6 +663,16 @@ func (c *Client) NewExchangeInfoService() *ExchangeInfoService {
 	return &ExchangeInfoService{c: c}
 }
 
+// NewSymbolRegistry init symbol registry
+func (c *Client) NewSymbolRegistry() *SymbolRegistry {
+	return &SymbolRegistry{c: c}
+}
+
+// NewOrderValidator init order validator
+func (c *Client) NewOrderValidator() *OrderValidator {
+	return &OrderValidator{c: c}
+}
+
 // NewPremiumIndexService init premium index service
 func (c *Client) NewPremiumIndexService() *PremiumIndexService {
 	return &PremiumIndexService{c: c}
@@ -0,0 +1,116 @@
+package futures
+
+import "github.com/adshao/go-binance/v2/common"
+
+// Decimal accessors of the string amounts of responses, invalid or empty
+// strings are 0. Use common.ParseDecimal to check the value.
+
+// PriceDecimal return Price as a decimal
+func (o *Order) PriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.Price)
+}
+
+// OrigQuantityDecimal return OrigQuantity as a decimal
+func (o *Order) OrigQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.OrigQuantity)
+}
+
+// ExecutedQuantityDecimal return ExecutedQuantity as a decimal
+func (o *Order) ExecutedQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.ExecutedQuantity)
+}
+
+// CumQuantityDecimal return CumQuantity as a decimal
+func (o *Order) CumQuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.CumQuantity)
+}
+
+// CumQuoteDecimal return CumQuote as a decimal
+func (o *Order) CumQuoteDecimal() common.Decimal {
+	return common.DecimalOrZero(o.CumQuote)
+}
+
+// StopPriceDecimal return StopPrice as a decimal
+func (o *Order) StopPriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.StopPrice)
+}
+
+// ActivatePriceDecimal return ActivatePrice as a decimal
+func (o *Order) ActivatePriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.ActivatePrice)
+}
+
+// PriceRateDecimal return PriceRate as a decimal
+func (o *Order) PriceRateDecimal() common.Decimal {
+	return common.DecimalOrZero(o.PriceRate)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal
+func (o *Order) AvgPriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.AvgPrice)
+}
+
+// BalanceDecimal return Balance as a decimal
+func (b *Balance) BalanceDecimal() common.Decimal {
+	return common.DecimalOrZero(b.Balance)
+}
+
+// CrossWalletBalanceDecimal return CrossWalletBalance as a decimal
+func (b *Balance) CrossWalletBalanceDecimal() common.Decimal {
+	return common.DecimalOrZero(b.CrossWalletBalance)
+}
+
+// CrossUnPnlDecimal return CrossUnPnl as a decimal
+func (b *Balance) CrossUnPnlDecimal() common.Decimal {
+	return common.DecimalOrZero(b.CrossUnPnl)
+}
+
+// AvailableBalanceDecimal return AvailableBalance as a decimal
+func (b *Balance) AvailableBalanceDecimal() common.Decimal {
+	return common.DecimalOrZero(b.AvailableBalance)
+}
+
+// MaxWithdrawAmountDecimal return MaxWithdrawAmount as a decimal
+func (b *Balance) MaxWithdrawAmountDecimal() common.Decimal {
+	return common.DecimalOrZero(b.MaxWithdrawAmount)
+}
+
+// OpenDecimal return Open as a decimal
+func (k *Kline) OpenDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Open)
+}
+
+// HighDecimal return High as a decimal
+func (k *Kline) HighDecimal() common.Decimal {
+	return common.DecimalOrZero(k.High)
+}
+
+// LowDecimal return Low as a decimal
+func (k *Kline) LowDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Low)
+}
+
+// CloseDecimal return Close as a decimal
+func (k *Kline) CloseDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Close)
+}
+
+// VolumeDecimal return Volume as a decimal
+func (k *Kline) VolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Volume)
+}
+
+// QuoteAssetVolumeDecimal return QuoteAssetVolume as a decimal
+func (k *Kline) QuoteAssetVolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.QuoteAssetVolume)
+}
+
+// TakerBuyBaseAssetVolumeDecimal return TakerBuyBaseAssetVolume as a decimal
+func (k *Kline) TakerBuyBaseAssetVolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.TakerBuyBaseAssetVolume)
+}
+
+// TakerBuyQuoteAssetVolumeDecimal return TakerBuyQuoteAssetVolume as a decimal
+func (k *Kline) TakerBuyQuoteAssetVolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.TakerBuyQuoteAssetVolume)
+}
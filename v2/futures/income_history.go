@@ -3,7 +3,11 @@ package futures
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // GetIncomeHistoryService get position margin history service
@@ -79,6 +83,32 @@ func (s *GetIncomeHistoryService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterate walk the incomes by windows of 7 days from startTime (default 7 days
+// before endTime) to endTime (default now)
+func (s *GetIncomeHistoryService) Iterate(opts ...RequestOption) *common.Iterator[*IncomeHistory] {
+	limit := int64(1000)
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	end := time.Now().UnixMilli()
+	if s.endTime != nil {
+		end = *s.endTime
+	}
+	start := end - (7 * 24 * time.Hour).Milliseconds() + 1
+	if s.startTime != nil {
+		start = *s.startTime
+	}
+	return common.NewTimeIterator(start, end, 7*24*time.Hour, int(limit),
+		func(ctx context.Context, start, end int64) ([]*IncomeHistory, error) {
+			page := *s
+			return page.StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+		},
+		func(i *IncomeHistory) int64 { return i.Time },
+		func(i *IncomeHistory) string {
+			return fmt.Sprintf("%d/%s/%s/%s", i.TranID, i.IncomeType, i.Symbol, i.Asset)
+		})
+}
+
 // IncomeHistory define position margin history info
 type IncomeHistory struct {
 	Asset      string `json:"asset"`
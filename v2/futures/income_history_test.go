@@ -1,6 +1,8 @@
 package futures
 
 import (
+	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -72,3 +74,28 @@ func (s *incomeHistoryServiceTestSuite) assertOrderEqual(e, a *IncomeHistory) {
 	r.Equal(e.TranID, a.TranID, "TranID")
 	r.Equal(e.TradeID, a.TradeID, "TradeID")
 }
+
+func (s *incomeHistoryServiceTestSuite) TestIncomeHistoryIterate() {
+	pages := []string{
+		`[{"incomeType": "FUNDING_FEE", "time": 1000, "tranId": 1}, {"incomeType": "FUNDING_FEE", "time": 2000, "tranId": 2}]`,
+		`[{"incomeType": "FUNDING_FEE", "time": 2000, "tranId": 2}, {"incomeType": "FUNDING_FEE", "time": 3000, "tranId": 3}]`,
+		`[{"incomeType": "FUNDING_FEE", "time": 3000, "tranId": 3}]`,
+	}
+	var queries []url.Values
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		queries = append(queries, req.URL.Query())
+		return newHTTPResponse([]byte(pages[len(queries)-1]), http.StatusOK), nil
+	}
+	incomes, err := s.client.NewGetIncomeHistoryService().Symbol("BTCUSDT").StartTime(0).EndTime(5000).Limit(2).
+		Iterate().All(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(incomes, 3)
+	for i, income := range incomes {
+		r.Equal(int64(i+1), income.TranID)
+	}
+	r.Len(queries, 3)
+	r.Equal("2000", queries[1].Get("startTime"))
+	r.Equal("3000", queries[2].Get("startTime"))
+	r.Equal("5000", queries[2].Get("endTime"))
+}
@@ -0,0 +1,157 @@
+package futures
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const maxKlinesLimit = 1500
+
+// KlineDownloadService download the klines of any time range, the pages of
+// at most 1500 klines are fetched concurrently. The klines are the ones of
+// the symbol, of the continuous contract if Pair is set, or of the mark price
+// if MarkPrice is set.
+type KlineDownloadService struct {
+	c            *Client
+	symbol       string
+	pair         string
+	contractType ContractType
+	markPrice    bool
+	interval     string
+	startTime    int64
+	endTime      *int64
+	limit        int
+	concurrency  int
+}
+
+// Symbol set symbol
+func (s *KlineDownloadService) Symbol(symbol string) *KlineDownloadService {
+	s.symbol = symbol
+	return s
+}
+
+// Pair set pair to download the klines of a continuous contract
+func (s *KlineDownloadService) Pair(pair string) *KlineDownloadService {
+	s.pair = pair
+	return s
+}
+
+// ContractType set contractType of the continuous contract, default PERPETUAL
+func (s *KlineDownloadService) ContractType(contractType ContractType) *KlineDownloadService {
+	s.contractType = contractType
+	return s
+}
+
+// MarkPrice set whether to download the mark price klines of the symbol
+func (s *KlineDownloadService) MarkPrice(markPrice bool) *KlineDownloadService {
+	s.markPrice = markPrice
+	return s
+}
+
+// Interval set interval
+func (s *KlineDownloadService) Interval(interval string) *KlineDownloadService {
+	s.interval = interval
+	return s
+}
+
+// StartTime set the start of the range in milliseconds, inclusive
+func (s *KlineDownloadService) StartTime(startTime int64) *KlineDownloadService {
+	s.startTime = startTime
+	return s
+}
+
+// EndTime set the end of the range in milliseconds, exclusive, default now
+func (s *KlineDownloadService) EndTime(endTime int64) *KlineDownloadService {
+	s.endTime = &endTime
+	return s
+}
+
+// Limit set the number of klines per page, default 1500
+func (s *KlineDownloadService) Limit(limit int) *KlineDownloadService {
+	s.limit = limit
+	return s
+}
+
+// Concurrency set the number of pages fetched at once, default 1
+func (s *KlineDownloadService) Concurrency(concurrency int) *KlineDownloadService {
+	s.concurrency = concurrency
+	return s
+}
+
+// Do download the klines of [startTime, endTime)
+func (s *KlineDownloadService) Do(ctx context.Context, opts ...RequestOption) (*KlineDownload, error) {
+	end := time.Now().UnixMilli()
+	if s.endTime != nil {
+		end = *s.endTime
+	}
+	limit := s.limit
+	if limit <= 0 || limit > maxKlinesLimit {
+		limit = maxKlinesLimit
+	}
+	d := &common.KlineDownloader[*Kline]{
+		Interval:    s.interval,
+		Limit:       limit,
+		Concurrency: s.concurrency,
+		Fetch: func(ctx context.Context, start, end int64, limit int) ([]*Kline, error) {
+			return s.fetch(ctx, start, end, limit, opts...)
+		},
+		OpenTime: func(k *Kline) int64 { return k.OpenTime },
+	}
+	klines, gaps, err := d.Download(ctx, s.startTime, end)
+	if err != nil {
+		return nil, err
+	}
+	return &KlineDownload{Klines: klines, Gaps: gaps}, nil
+}
+
+func (s *KlineDownloadService) fetch(ctx context.Context, start, end int64, limit int, opts ...RequestOption) ([]*Kline, error) {
+	switch {
+	case s.pair != "":
+		contractType := s.contractType
+		if contractType == "" {
+			contractType = ContractTypePerpetual
+		}
+		res, err := s.c.NewContinuousKlinesService().Pair(s.pair).ContractType(string(contractType)).
+			Interval(s.interval).StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+		if err != nil {
+			return nil, err
+		}
+		klines := make([]*Kline, len(res))
+		for i, k := range res {
+			klines[i] = (*Kline)(k)
+		}
+		return klines, nil
+	case s.markPrice:
+		return s.c.NewMarkPriceKlinesService().Symbol(s.symbol).Interval(s.interval).
+			StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+	}
+	return s.c.NewKlinesService().Symbol(s.symbol).Interval(s.interval).
+		StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+}
+
+// KlineDownload define the downloaded klines sorted by open time, and the
+// ranges in which klines are missing
+type KlineDownload struct {
+	Klines []*Kline
+	Gaps   []common.KlineGap
+}
+
+// WriteCSV write the klines as CSV with a header line
+func (d *KlineDownload) WriteCSV(w io.Writer) error {
+	return common.WriteCSV(w, common.KlineCSVHeader, d.Klines, func(k *Kline) []string {
+		return []string{
+			strconv.FormatInt(k.OpenTime, 10), k.Open, k.High, k.Low, k.Close, k.Volume,
+			strconv.FormatInt(k.CloseTime, 10), k.QuoteAssetVolume, strconv.FormatInt(k.TradeNum, 10),
+			k.TakerBuyBaseAssetVolume, k.TakerBuyQuoteAssetVolume,
+		}
+	})
+}
+
+// WriteNDJSON write the klines as JSON, one per line
+func (d *KlineDownload) WriteNDJSON(w io.Writer) error {
+	return common.WriteNDJSON(w, d.Klines)
+}
@@ -0,0 +1,90 @@
+package futures
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type klineDownloadTestSuite struct {
+	baseTestSuite
+	mu       sync.Mutex
+	requests []*url.URL
+}
+
+func TestKlineDownload(t *testing.T) {
+	suite.Run(t, new(klineDownloadTestSuite))
+}
+
+// mockKlines answer the klines requests with a kline per minute of
+// [startTime, endTime], except the missing ones
+func (s *klineDownloadTestSuite) mockKlines(missing ...int64) {
+	s.requests = nil
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		start, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		s.mu.Lock()
+		s.requests = append(s.requests, req.URL)
+		s.mu.Unlock()
+		var klines []string
+	next:
+		for t := start; t <= end; t += 60000 {
+			for _, m := range missing {
+				if t == m {
+					continue next
+				}
+			}
+			klines = append(klines, fmt.Sprintf(`[%d, "1.0", "2.0", "0.5", "1.5", "10", %d, "15", 3, "5", "7.5", "0"]`, t, t+59999))
+		}
+		return newHTTPResponse([]byte("["+strings.Join(klines, ",")+"]"), http.StatusOK), nil
+	}
+}
+
+func (s *klineDownloadTestSuite) TestDownload() {
+	s.mockKlines(0)
+	res, err := s.client.NewKlineDownloadService().Symbol("BTCUSDT").Interval("1m").
+		StartTime(0).EndTime(180000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(s.requests, 1)
+	r.Equal("/fapi/v1/klines", s.requests[0].Path)
+	r.Equal("1500", s.requests[0].Query().Get("limit"))
+	r.Equal("179999", s.requests[0].Query().Get("endTime"))
+	r.Len(res.Klines, 2)
+	r.Equal([]common.KlineGap{{Start: 0, End: 60000}}, res.Gaps)
+}
+
+func (s *klineDownloadTestSuite) TestDownloadContinuous() {
+	s.mockKlines()
+	res, err := s.client.NewKlineDownloadService().Pair("BTCUSDT").Interval("1m").
+		StartTime(0).EndTime(240000).Limit(2).Concurrency(2).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(s.requests, 2)
+	for _, u := range s.requests {
+		r.Equal("/fapi/v1/continuousKlines", u.Path)
+		r.Equal("PERPETUAL", u.Query().Get("contractType"))
+	}
+	r.Len(res.Klines, 4)
+	r.Equal("7.5", res.Klines[3].TakerBuyQuoteAssetVolume)
+	r.Empty(res.Gaps)
+}
+
+func (s *klineDownloadTestSuite) TestDownloadMarkPrice() {
+	s.mockKlines()
+	res, err := s.client.NewKlineDownloadService().Symbol("BTCUSDT").MarkPrice(true).Interval("1m").
+		StartTime(0).EndTime(120000).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(s.requests, 1)
+	r.Equal("/fapi/v1/markPriceKlines", s.requests[0].Path)
+	r.Len(res.Klines, 2)
+}
@@ -0,0 +1,180 @@
+package futures
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type modifyOrderServiceTestSuite struct {
+	baseOrderTestSuite
+}
+
+func TestModifyOrderService(t *testing.T) {
+	suite.Run(t, new(modifyOrderServiceTestSuite))
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyOrder() {
+	data := []byte(`{
+		"orderId": 20072994037,
+		"symbol": "BTCUSDT",
+		"pair": "BTCUSDT",
+		"status": "NEW",
+		"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+		"price": "30005",
+		"avgPrice": "0.0",
+		"origQty": "1",
+		"executedQty": "0",
+		"cumQty": "0",
+		"cumBase": "0",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"reduceOnly": false,
+		"closePosition": false,
+		"side": "BUY",
+		"positionSide": "LONG",
+		"stopPrice": "0",
+		"workingType": "CONTRACT_PRICE",
+		"priceProtect": false,
+		"origType": "LIMIT",
+		"priceMatch": "NONE",
+		"selfTradePreventionMode": "NONE",
+		"goodTillDate": 0,
+		"updateTime": 1629182711600
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":   "BTCUSDT",
+			"orderId":  int64(20072994037),
+			"side":     SideTypeBuy,
+			"quantity": "1",
+			"price":    "30005",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(20072994037).
+		Side(SideTypeBuy).Quantity("1").Price("30005").Do(newContext())
+	s.r().NoError(err)
+	s.assertOrderEqual(&Order{
+		Symbol:                  "BTCUSDT",
+		OrderID:                 20072994037,
+		ClientOrderID:           "LJ9R4QZDihCaS8UAOOLpgW",
+		Price:                   "30005",
+		OrigQuantity:            "1",
+		ExecutedQuantity:        "0",
+		CumQuantity:             "0",
+		Status:                  OrderStatusTypeNew,
+		TimeInForce:             TimeInForceTypeGTC,
+		Type:                    OrderTypeLimit,
+		Side:                    SideTypeBuy,
+		StopPrice:               "0",
+		UpdateTime:              1629182711600,
+		WorkingType:             WorkingTypeContractPrice,
+		AvgPrice:                "0.0",
+		OrigType:                OrderTypeLimit,
+		PositionSide:            PositionSideTypeLong,
+		PriceMatch:              "NONE",
+		SelfTradePreventionMode: STPModeTypeNone,
+	}, res)
+}
+
+func (s *modifyOrderServiceTestSuite) TestModifyBatchOrders() {
+	data := []byte(`[
+		{
+			"orderId": 42042723,
+			"symbol": "BTCUSDT",
+			"status": "NEW",
+			"clientOrderId": "Ne7DEEvLvv8b9xlwL1JG8K",
+			"price": "99995.00",
+			"origQty": "1",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "BUY",
+			"priceMatch": "OPPONENT",
+			"updateTime": 1733117453012
+		},
+		{
+			"code": -2013,
+			"msg": "Order does not exist."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"batchOrders": `[{"orderId":42042723,"priceMatch":"OPPONENT","quantity":"1","side":"BUY","symbol":"BTCUSDT"},` +
+				`{"origClientOrderId":"myOrder2","price":"100","quantity":"2","side":"SELL","symbol":"BTCUSDT"}]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewModifyBatchOrdersService().OrderList([]*ModifyOrderService{
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(42042723).Side(SideTypeBuy).
+			Quantity("1").PriceMatch(PriceMatchTypeOpponent),
+		s.client.NewModifyOrderService().Symbol("BTCUSDT").OrigClientOrderID("myOrder2").Side(SideTypeSell).
+			Quantity("2").Price("100"),
+	}).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	r.Equal(int64(42042723), res.Orders[0].OrderID)
+	r.Equal("OPPONENT", res.Orders[0].PriceMatch)
+	r.Nil(res.Errors[0])
+	apiErr, ok := common.AsAPIError(res.Errors[1])
+	r.True(ok)
+	r.Equal(int64(-2013), apiErr.Code)
+}
+
+func (s *modifyOrderServiceTestSuite) TestGetOrderModifyHistory() {
+	data := []byte(`[
+		{
+			"amendmentId": 5363,
+			"symbol": "BTCUSDT",
+			"pair": "BTCUSDT",
+			"orderId": 20072994037,
+			"clientOrderId": "LJ9R4QZDihCaS8UAOOLpgW",
+			"time": 1629184560899,
+			"amendment": {
+				"price": {"before": "30004", "after": "30003.2"},
+				"origQty": {"before": "1", "after": "1"},
+				"count": 3
+			}
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":    "BTCUSDT",
+			"orderId":   int64(20072994037),
+			"startTime": int64(1629184000000),
+			"endTime":   int64(1629185000000),
+			"limit":     10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewGetOrderModifyHistoryService().Symbol("BTCUSDT").OrderID(20072994037).
+		StartTime(1629184000000).EndTime(1629185000000).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*OrderAmendment{{
+		AmendmentID:   5363,
+		Symbol:        "BTCUSDT",
+		Pair:          "BTCUSDT",
+		OrderID:       20072994037,
+		ClientOrderID: "LJ9R4QZDihCaS8UAOOLpgW",
+		Time:          1629184560899,
+		Amendment: AmendmentDetail{
+			Price:   AmendmentValue{Before: "30004", After: "30003.2"},
+			OrigQty: AmendmentValue{Before: "1", After: "1"},
+			Count:   3,
+		},
+	}}, res)
+}
@@ -69,6 +69,11 @@ func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
 	return s
 }
 
+// QuantityDecimal set quantity
+func (s *CreateOrderService) QuantityDecimal(quantity common.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
 // ReduceOnly set reduceOnly
 func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
 	reduceOnlyStr := strconv.FormatBool(reduceOnly)
@@ -82,6 +87,11 @@ func (s *CreateOrderService) Price(price string) *CreateOrderService {
 	return s
 }
 
+// PriceDecimal set price
+func (s *CreateOrderService) PriceDecimal(price common.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
 // NewClientOrderID set newClientOrderID
 func (s *CreateOrderService) NewClientOrderID(newClientOrderID string) *CreateOrderService {
 	s.newClientOrderID = &newClientOrderID
@@ -94,6 +104,11 @@ func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
 	return s
 }
 
+// StopPriceDecimal set stopPrice
+func (s *CreateOrderService) StopPriceDecimal(stopPrice common.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // WorkingType set workingType
 func (s *CreateOrderService) WorkingType(workingType WorkingType) *CreateOrderService {
 	s.workingType = &workingType
@@ -106,12 +121,22 @@ func (s *CreateOrderService) ActivationPrice(activationPrice string) *CreateOrde
 	return s
 }
 
+// ActivationPriceDecimal set activationPrice
+func (s *CreateOrderService) ActivationPriceDecimal(activationPrice common.Decimal) *CreateOrderService {
+	return s.ActivationPrice(activationPrice.String())
+}
+
 // CallbackRate set callbackRate
 func (s *CreateOrderService) CallbackRate(callbackRate string) *CreateOrderService {
 	s.callbackRate = &callbackRate
 	return s
 }
 
+// CallbackRateDecimal set callbackRate
+func (s *CreateOrderService) CallbackRateDecimal(callbackRate common.Decimal) *CreateOrderService {
+	return s.CallbackRate(callbackRate.String())
+}
+
 // PriceProtect set priceProtect
 func (s *CreateOrderService) PriceProtect(priceProtect bool) *CreateOrderService {
 	priceProtectStr := strconv.FormatBool(priceProtect)
@@ -132,13 +157,7 @@ func (s *CreateOrderService) ClosePosition(closePosition bool) *CreateOrderServi
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
-
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+func (s *CreateOrderService) buildParams() params {
 	m := params{
 		"symbol":           s.symbol,
 		"side":             s.side,
@@ -181,7 +200,17 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.closePosition != nil {
 		m["closePosition"] = *s.closePosition
 	}
-	r.setFormParams(m)
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.buildParams())
 	data, header, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, &http.Header{}, err
@@ -349,6 +378,19 @@ func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderS
 	return s
 }
 
+func (s *GetOrderService) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	return m
+}
+
 // Do send request
 func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
 	r := &request{
@@ -356,13 +398,7 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 		endpoint: "/fapi/v1/order",
 		secType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
-	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
-	}
-	if s.origClientOrderID != nil {
-		r.setParam("origClientOrderId", *s.origClientOrderID)
-	}
+	r.setParams(s.buildParams())
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -504,6 +540,19 @@ func (s *CancelOrderService) OrigClientOrderID(origClientOrderID string) *Cancel
 	return s
 }
 
+func (s *CancelOrderService) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	return m
+}
+
 // Do send request
 func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
 	r := &request{
@@ -511,13 +560,7 @@ func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res
 		endpoint: "/fapi/v1/order",
 		secType:  secTypeSigned,
 	}
-	r.setFormParam("symbol", s.symbol)
-	if s.orderID != nil {
-		r.setFormParam("orderId", *s.orderID)
-	}
-	if s.origClientOrderID != nil {
-		r.setFormParam("origClientOrderId", *s.origClientOrderID)
-	}
+	r.setFormParams(s.buildParams())
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -608,8 +651,20 @@ func (s *CancelMultiplesOrdersService) OrigClientOrderIDList(origClientOrderIDLi
 	return s
 }
 
+// CancelMultipleOrdersResponse contains the response from CancelMultipleOrders operation
+type CancelMultipleOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were canceled successfully which can have a length between 0 and N
+	Orders []*CancelOrderResponse
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was canceled successfully OR a non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
 // Do send request
-func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*CancelOrderResponse, err error) {
+func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelMultipleOrdersResponse, err error) {
 	r := &request{
 		method:   http.MethodDelete,
 		endpoint: "/fapi/v1/batchOrders",
@@ -622,16 +677,37 @@ func (s *CancelMultiplesOrdersService) Do(ctx context.Context, opts ...RequestOp
 		r.setFormParam("orderIdList", orderIDListString)
 	}
 	if s.origClientOrderIDList != nil {
-		r.setFormParam("origClientOrderIdList", s.origClientOrderIDList)
+		b, err := json.Marshal(s.origClientOrderIDList)
+		if err != nil {
+			return nil, err
+		}
+		r.setFormParam("origClientOrderIdList", string(b))
 	}
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
 	}
-	res = make([]*CancelOrderResponse, 0)
-	err = json.Unmarshal(data, &res)
+	rawMessages := make([]*json.RawMessage, 0)
+	err = json.Unmarshal(data, &rawMessages)
 	if err != nil {
-		return []*CancelOrderResponse{}, err
+		return nil, err
+	}
+	res = &CancelMultipleOrdersResponse{N: len(rawMessages), Errors: make([]error, len(rawMessages))}
+	for i, j := range rawMessages {
+		// check if response is an API error
+		e := new(common.APIError)
+		if err := json.Unmarshal(*j, e); err != nil {
+			return nil, err
+		}
+		if e.IsValid() {
+			res.Errors[i] = e
+			continue
+		}
+		o := new(CancelOrderResponse)
+		if err := json.Unmarshal(*j, o); err != nil {
+			return nil, err
+		}
+		res.Orders = append(res.Orders, o)
 	}
 	return res, nil
 }
@@ -528,6 +528,76 @@ func (s *orderServiceTestSuite) assertCancelOrderResponseEqual(e, a *CancelOrder
 	r.Equal(e.PriceProtect, a.PriceProtect, "PriceProtect")
 }
 
+func (s *orderServiceTestSuite) TestCancelMultipleOrders() {
+	data := []byte(`[
+		{
+			"clientOrderId": "myOrder1",
+			"cumQty": "0",
+			"cumQuote": "0",
+			"executedQty": "0",
+			"orderId": 283194212,
+			"origQty": "11",
+			"price": "8301",
+			"reduceOnly": false,
+			"side": "BUY",
+			"status": "CANCELED",
+			"stopPrice": "0",
+			"symbol": "BTCUSDT",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"updateTime": 1571110484038,
+			"positionSide": "BOTH"
+		},
+		{
+			"code": -2011,
+			"msg": "Unknown order sent."
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	symbol := "BTCUSDT"
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                symbol,
+			"orderIdList":           "[283194212,283194213]",
+			"origClientOrderIdList": `["myOrder1","myOrder2"]`,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCancelMultipleOrdersService().Symbol(symbol).
+		OrderIDList([]int64{283194212, 283194213}).
+		OrigClientOrderIDList([]string{"myOrder1", "myOrder2"}).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(2, res.N)
+	r.Len(res.Orders, 1)
+	s.assertCancelOrderResponseEqual(&CancelOrderResponse{
+		ClientOrderID:    "myOrder1",
+		CumQuantity:      "0",
+		CumQuote:         "0",
+		ExecutedQuantity: "0",
+		OrderID:          283194212,
+		OrigQuantity:     "11",
+		Price:            "8301",
+		ReduceOnly:       false,
+		Side:             SideTypeBuy,
+		Status:           OrderStatusTypeCanceled,
+		StopPrice:        "0",
+		Symbol:           symbol,
+		TimeInForce:      TimeInForceTypeGTC,
+		Type:             OrderTypeLimit,
+		UpdateTime:       1571110484038,
+		PositionSide:     PositionSideTypeBoth,
+	}, res.Orders[0])
+	r.NoError(res.Errors[0])
+	apiErr, ok := res.Errors[1].(*common.APIError)
+	r.True(ok)
+	r.Equal(int64(-2011), apiErr.Code)
+}
+
 func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
 	data := []byte(`{
 		"code": "200",
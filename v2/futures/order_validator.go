@@ -0,0 +1,285 @@
+package futures
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderValidator check orders against the filters of their symbol before they
+// are sent, so that they are not rejected by the server. The exchange info is
+// loaded on first use and cached.
+type OrderValidator struct {
+	// TTL of the cached exchange info, 0 to keep it until Reload
+	TTL time.Duration
+	// CheckPercentPrice fetch the mark price of the symbol to check the
+	// PERCENT_PRICE filter and the notional of market orders
+	CheckPercentPrice bool
+	// CheckOpenOrders fetch the open orders of the symbol to check the
+	// MAX_NUM_ORDERS and MAX_NUM_ALGO_ORDERS filters
+	CheckOpenOrders bool
+	// Registry provides the symbols instead of the cache of the validator if set
+	Registry *SymbolRegistry
+
+	c        *Client
+	mu       sync.Mutex
+	symbols  map[string]*Symbol
+	loadedAt time.Time
+}
+
+// Reload load the exchange info
+func (v *OrderValidator) Reload(ctx context.Context) error {
+	info, err := v.c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	v.SetExchangeInfo(info)
+	return nil
+}
+
+// SetExchangeInfo set the exchange info, e.g. one already loaded by the caller
+func (v *OrderValidator) SetExchangeInfo(info *ExchangeInfo) {
+	symbols := make(map[string]*Symbol, len(info.Symbols))
+	for i := range info.Symbols {
+		symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+	}
+	v.mu.Lock()
+	v.symbols = symbols
+	v.loadedAt = time.Now()
+	v.mu.Unlock()
+}
+
+// Symbol return the cached info of symbol, loading the exchange info if needed
+func (v *OrderValidator) Symbol(ctx context.Context, symbol string) (*Symbol, error) {
+	if v.Registry != nil {
+		if _, loaded := v.Registry.Loaded(); !loaded {
+			if err := v.Registry.Refresh(ctx); err != nil {
+				return nil, err
+			}
+		}
+		s, ok := v.Registry.Symbol(symbol)
+		if !ok {
+			return nil, fmt.Errorf("unknown symbol %q", symbol)
+		}
+		return s, nil
+	}
+	v.mu.Lock()
+	expired := v.symbols == nil || (v.TTL > 0 && time.Since(v.loadedAt) > v.TTL)
+	v.mu.Unlock()
+	if expired {
+		if err := v.Reload(ctx); err != nil {
+			return nil, err
+		}
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	s, ok := v.symbols[symbol]
+	if !ok {
+		return nil, fmt.Errorf("unknown symbol %q", symbol)
+	}
+	return s, nil
+}
+
+// Validate check the order of s, it returns a *common.FilterError naming the
+// first filter the order breaks
+func (v *OrderValidator) Validate(ctx context.Context, s *CreateOrderService) error {
+	symbol, err := v.Symbol(ctx, s.symbol)
+	if err != nil {
+		return err
+	}
+	var markPrice common.Decimal
+	if v.CheckPercentPrice {
+		res, err := v.c.NewPremiumIndexService().Symbol(s.symbol).Do(ctx)
+		if err != nil {
+			return err
+		}
+		if len(res) > 0 {
+			markPrice = common.DecimalOrZero(res[0].MarkPrice)
+		}
+	}
+	openOrders, openAlgoOrders := -1, -1
+	if v.CheckOpenOrders {
+		orders, err := v.c.NewListOpenOrdersService().Symbol(s.symbol).Do(ctx)
+		if err != nil {
+			return err
+		}
+		openOrders, openAlgoOrders = len(orders), 0
+		for _, o := range orders {
+			if isAlgoOrderType(o.Type) {
+				openAlgoOrders++
+			}
+		}
+	}
+	return validateOrder(symbol, s, markPrice, openOrders, openAlgoOrders)
+}
+
+// Normalize round down the price, stop price and quantity of s to the tick
+// size and step size of the symbol, then validate it
+func (v *OrderValidator) Normalize(ctx context.Context, s *CreateOrderService) error {
+	symbol, err := v.Symbol(ctx, s.symbol)
+	if err != nil {
+		return err
+	}
+	if f := symbol.PriceFilter(); f != nil {
+		min, tick := common.DecimalOrZero(f.MinPrice), common.DecimalOrZero(f.TickSize)
+		if s.price != nil {
+			s.PriceDecimal(roundToStep(common.DecimalOrZero(*s.price), min, tick))
+		}
+		if s.stopPrice != nil {
+			s.StopPriceDecimal(roundToStep(common.DecimalOrZero(*s.stopPrice), min, tick))
+		}
+		if s.activationPrice != nil {
+			s.ActivationPriceDecimal(roundToStep(common.DecimalOrZero(*s.activationPrice), min, tick))
+		}
+	}
+	lot := symbol.LotSizeFilter()
+	if market := symbol.MarketLotSizeFilter(); market != nil && isMarketOrderType(s.orderType) {
+		lot = (*LotSizeFilter)(market)
+	}
+	if lot != nil && s.quantity != "" {
+		min, step := common.DecimalOrZero(lot.MinQuantity), common.DecimalOrZero(lot.StepSize)
+		s.QuantityDecimal(roundToStep(common.DecimalOrZero(s.quantity), min, step))
+	}
+	return v.Validate(ctx, s)
+}
+
+func isMarketOrderType(t OrderType) bool {
+	switch t {
+	case OrderTypeMarket, OrderTypeStopMarket, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+func isAlgoOrderType(t OrderType) bool {
+	switch t {
+	case OrderTypeStop, OrderTypeStopMarket, OrderTypeTakeProfit, OrderTypeTakeProfitMarket, OrderTypeTrailingStopMarket:
+		return true
+	}
+	return false
+}
+
+// roundToStep round v down to min plus a multiple of step
+func roundToStep(v, min, step common.Decimal) common.Decimal {
+	if step.Sign() <= 0 || v.Cmp(min) < 0 {
+		return v
+	}
+	return min.Add(v.Sub(min).TruncateToStep(step)).Trim()
+}
+
+func validateOrder(symbol *Symbol, s *CreateOrderService, markPrice common.Decimal, openOrders, openAlgoOrders int) error {
+	fail := func(filter SymbolFilterType, field string, value interface{}, reason string, args ...interface{}) error {
+		return &common.FilterError{
+			Symbol: symbol.Symbol,
+			Filter: string(filter),
+			Field:  field,
+			Value:  fmt.Sprint(value),
+			Reason: fmt.Sprintf(reason, args...),
+		}
+	}
+	parse := func(field string, v *string) (common.Decimal, bool, error) {
+		if v == nil || *v == "" {
+			return common.Decimal{}, false, nil
+		}
+		d, err := common.ParseDecimal(*v)
+		if err != nil {
+			return d, false, fmt.Errorf("invalid %s: %w", field, err)
+		}
+		return d, true, nil
+	}
+	market := isMarketOrderType(s.orderType)
+
+	price, hasPrice, err := parse("price", s.price)
+	if err != nil {
+		return err
+	}
+	stopPrice, hasStopPrice, err := parse("stopPrice", s.stopPrice)
+	if err != nil {
+		return err
+	}
+	quantity, hasQuantity, err := parse("quantity", &s.quantity)
+	if err != nil {
+		return err
+	}
+
+	if f := symbol.PriceFilter(); f != nil {
+		min, max, tick := common.DecimalOrZero(f.MinPrice), common.DecimalOrZero(f.MaxPrice), common.DecimalOrZero(f.TickSize)
+		check := func(field string, p common.Decimal) error {
+			switch {
+			case min.Sign() > 0 && p.Cmp(min) < 0:
+				return fail(SymbolFilterTypePrice, field, p, "is less than minPrice %s", f.MinPrice)
+			case max.Sign() > 0 && p.Cmp(max) > 0:
+				return fail(SymbolFilterTypePrice, field, p, "is greater than maxPrice %s", f.MaxPrice)
+			case tick.Sign() > 0 && !p.Sub(min).IsMultipleOf(tick):
+				return fail(SymbolFilterTypePrice, field, p, "is not a multiple of tickSize %s", f.TickSize)
+			}
+			return nil
+		}
+		if hasPrice {
+			if err := check("price", price); err != nil {
+				return err
+			}
+		}
+		if hasStopPrice {
+			if err := check("stopPrice", stopPrice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if f := symbol.PercentPriceFilter(); f != nil && hasPrice && markPrice.Sign() > 0 {
+		if s.side == SideTypeBuy {
+			if high := markPrice.Mul(common.DecimalOrZero(f.MultiplierUp)); high.Sign() > 0 && price.Cmp(high) > 0 {
+				return fail(SymbolFilterTypePercentPrice, "price", price, "is greater than %s times the mark price %s", f.MultiplierUp, markPrice)
+			}
+		} else if low := markPrice.Mul(common.DecimalOrZero(f.MultiplierDown)); price.Cmp(low) < 0 {
+			return fail(SymbolFilterTypePercentPrice, "price", price, "is less than %s times the mark price %s", f.MultiplierDown, markPrice)
+		}
+	}
+
+	checkLot := func(filter SymbolFilterType, q common.Decimal, minQty, maxQty, stepSize string) error {
+		min, max, step := common.DecimalOrZero(minQty), common.DecimalOrZero(maxQty), common.DecimalOrZero(stepSize)
+		switch {
+		case q.Cmp(min) < 0:
+			return fail(filter, "quantity", q, "is less than minQty %s", minQty)
+		case max.Sign() > 0 && q.Cmp(max) > 0:
+			return fail(filter, "quantity", q, "is greater than maxQty %s", maxQty)
+		case step.Sign() > 0 && !q.Sub(min).IsMultipleOf(step):
+			return fail(filter, "quantity", q, "is not a multiple of stepSize %s", stepSize)
+		}
+		return nil
+	}
+	if f := symbol.MarketLotSizeFilter(); f != nil && market && hasQuantity {
+		if err := checkLot(SymbolFilterTypeMarketLotSize, quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+			return err
+		}
+	} else if f := symbol.LotSizeFilter(); f != nil && hasQuantity {
+		if err := checkLot(SymbolFilterTypeLotSize, quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+			return err
+		}
+	}
+
+	reduceOnly := (s.reduceOnly != nil && *s.reduceOnly == "true") || (s.closePosition != nil && *s.closePosition == "true")
+	if f := symbol.MinNotionalFilter(); f != nil && hasQuantity && !reduceOnly {
+		notionalPrice := price
+		if market || !hasPrice {
+			notionalPrice = markPrice
+		}
+		min := common.DecimalOrZero(f.Notional)
+		if notional := notionalPrice.Mul(quantity); notionalPrice.Sign() > 0 && notional.Cmp(min) < 0 {
+			return fail(SymbolFilterTypeMinNotional, "notional", notional, "is less than notional %s", f.Notional)
+		}
+	}
+
+	if f := symbol.MaxNumOrdersFilter(); f != nil && openOrders >= 0 && f.Limit > 0 && int64(openOrders) >= f.Limit {
+		return fail(SymbolFilterTypeMaxNumOrders, "openOrders", openOrders, "reached limit %d", f.Limit)
+	}
+	if f := symbol.MaxNumAlgoOrdersFilter(); f != nil && isAlgoOrderType(s.orderType) && openAlgoOrders >= 0 &&
+		f.Limit > 0 && int64(openAlgoOrders) >= f.Limit {
+		return fail(SymbolFilterTypeMaxNumAlgoOrders, "openAlgoOrders", openAlgoOrders, "reached limit %d", f.Limit)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package futures
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderValidatorTestSuite struct {
+	baseTestSuite
+	validator *OrderValidator
+}
+
+func TestOrderValidator(t *testing.T) {
+	suite.Run(t, new(orderValidatorTestSuite))
+}
+
+func (s *orderValidatorTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	info := new(ExchangeInfo)
+	err := json.Unmarshal([]byte(`{
+		"symbols": [
+			{
+				"symbol": "BTCUSDT",
+				"status": "TRADING",
+				"filters": [
+					{"filterType": "PRICE_FILTER", "minPrice": "556.80", "maxPrice": "4529764", "tickSize": "0.10"},
+					{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "1000", "stepSize": "0.001"},
+					{"filterType": "MARKET_LOT_SIZE", "minQty": "0.001", "maxQty": "120", "stepSize": "0.001"},
+					{"filterType": "MAX_NUM_ORDERS", "limit": 200},
+					{"filterType": "MAX_NUM_ALGO_ORDERS", "limit": 1},
+					{"filterType": "MIN_NOTIONAL", "notional": "100"},
+					{"filterType": "PERCENT_PRICE", "multiplierUp": "1.0500", "multiplierDown": "0.9500", "multiplierDecimal": "4"}
+				]
+			}
+		]
+	}`), info)
+	s.r().NoError(err)
+	s.validator = s.client.NewOrderValidator()
+	s.validator.SetExchangeInfo(info)
+}
+
+func (s *orderValidatorTestSuite) newOrder() *CreateOrderService {
+	return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.005").Price("30000.1")
+}
+
+func (s *orderValidatorTestSuite) assertFilterError(err error, filter SymbolFilterType, field string) {
+	s.r().Error(err)
+	s.r().True(errors.Is(err, common.ErrInvalidMessage))
+	filterErr, ok := common.AsFilterError(err)
+	s.r().True(ok, err.Error())
+	s.r().Equal(string(filter), filterErr.Filter)
+	s.r().Equal(field, filterErr.Field)
+}
+
+func (s *orderValidatorTestSuite) TestValidate() {
+	ctx := newContext()
+	s.r().NoError(s.validator.Validate(ctx, s.newOrder()))
+
+	err := s.validator.Validate(ctx, s.newOrder().Price("30000.15"))
+	s.assertFilterError(err, SymbolFilterTypePrice, "price")
+
+	err = s.validator.Validate(ctx, s.newOrder().Price("500"))
+	s.assertFilterError(err, SymbolFilterTypePrice, "price")
+
+	err = s.validator.Validate(ctx, s.newOrder().Quantity("0.0005"))
+	s.assertFilterError(err, SymbolFilterTypeLotSize, "quantity")
+
+	err = s.validator.Validate(ctx, s.newOrder().Type(OrderTypeMarket).Quantity("121"))
+	s.assertFilterError(err, SymbolFilterTypeMarketLotSize, "quantity")
+
+	err = s.validator.Validate(ctx, s.newOrder().Quantity("0.003"))
+	s.assertFilterError(err, SymbolFilterTypeMinNotional, "notional")
+	s.r().NoError(s.validator.Validate(ctx, s.newOrder().Quantity("0.003").ReduceOnly(true)))
+}
+
+func (s *orderValidatorTestSuite) TestValidatePercentPrice() {
+	s.mockDo([]byte(`{"symbol": "BTCUSDT", "markPrice": "30000.00"}`), nil)
+	defer s.assertDo()
+	s.validator.CheckPercentPrice = true
+	err := s.validator.Validate(newContext(), s.newOrder().Price("31500.1"))
+	s.assertFilterError(err, SymbolFilterTypePercentPrice, "price")
+	s.r().Equal("is greater than 1.0500 times the mark price 30000.00", err.(*common.FilterError).Reason)
+}
+
+func (s *orderValidatorTestSuite) TestValidateOpenAlgoOrders() {
+	s.mockDo([]byte(`[{"symbol": "BTCUSDT", "type": "STOP_MARKET"}]`), nil)
+	defer s.assertDo()
+	s.validator.CheckOpenOrders = true
+	order := s.newOrder().Type(OrderTypeStopMarket).StopPrice("29000")
+	err := s.validator.Validate(newContext(), order)
+	s.assertFilterError(err, SymbolFilterTypeMaxNumAlgoOrders, "openAlgoOrders")
+}
+
+func (s *orderValidatorTestSuite) TestNormalize() {
+	order := s.newOrder().Price("30000.19").Quantity("0.0059")
+	s.r().NoError(s.validator.Normalize(newContext(), order))
+	s.r().Equal("30000.1", *order.price)
+	s.r().Equal("0.005", order.quantity)
+}
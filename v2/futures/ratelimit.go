@@ -0,0 +1,117 @@
+package futures
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the weight of the /fapi endpoints which do not weigh 1
+var requestWeights = map[string]int64{
+	"GET /fapi/v1/historicalTrades":  20,
+	"GET /fapi/v1/aggTrades":         20,
+	"GET /fapi/v1/trades":            5,
+	"GET /fapi/v1/allOrders":         5,
+	"GET /fapi/v2/account":           5,
+	"GET /fapi/v2/balance":           5,
+	"GET /fapi/v2/positionRisk":      5,
+	"GET /fapi/v1/userTrades":        5,
+	"GET /fapi/v1/income":            30,
+	"GET /fapi/v1/positionSide/dual": 30,
+	"GET /fapi/v1/multiAssetsMargin": 30,
+	"GET /fapi/v1/commissionRate":    20,
+	"GET /fapi/v1/forceOrders":       20,
+	"GET /fapi/v1/allForceOrders":    20,
+	"GET /fapi/v1/ticker/bookTicker": 2,
+	"POST /fapi/v1/order":            0,
+	"POST /fapi/v1/batchOrders":      5,
+	"PUT /fapi/v1/batchOrders":       5,
+	"GET /fapi/v1/constituents":      2,
+}
+
+// orderCounts define the orders counted against the ORDERS limits by the endpoints placing orders
+var orderCounts = map[string]int64{
+	"POST /fapi/v1/order":       1,
+	"PUT /fapi/v1/order":        1,
+	"POST /fapi/v1/batchOrders": 5,
+	"PUT /fapi/v1/batchOrders":  5,
+}
+
+// requestCost return what r counts against the /fapi limits, false if r is
+// not counted against them
+func requestCost(r *request) (common.RequestCost, bool) {
+	if !strings.HasPrefix(r.endpoint, "/fapi/") {
+		return common.RequestCost{}, false
+	}
+	key := r.method + " " + r.endpoint
+	cost := common.RequestCost{Weight: 1, Orders: orderCounts[key]}
+	if w, ok := requestWeights[key]; ok {
+		cost.Weight = w
+	}
+	switch key {
+	case "GET /fapi/v1/depth":
+		limit, err := strconv.Atoi(r.query.Get("limit"))
+		if err != nil {
+			limit = 500
+		}
+		switch {
+		case limit > 500:
+			cost.Weight = 20
+		case limit > 100:
+			cost.Weight = 10
+		case limit > 50:
+			cost.Weight = 5
+		default:
+			cost.Weight = 2
+		}
+	case "GET /fapi/v1/klines", "GET /fapi/v1/continuousKlines", "GET /fapi/v1/indexPriceKlines",
+		"GET /fapi/v1/markPriceKlines", "GET /fapi/v1/premiumIndexKlines":
+		limit, err := strconv.Atoi(r.query.Get("limit"))
+		if err != nil {
+			limit = 500
+		}
+		switch {
+		case limit > 1000:
+			cost.Weight = 10
+		case limit >= 500:
+			cost.Weight = 5
+		case limit >= 100:
+			cost.Weight = 2
+		}
+	case "GET /fapi/v1/ticker/24hr", "GET /fapi/v1/openOrders":
+		if r.query.Get("symbol") == "" {
+			cost.Weight = 40
+		}
+	case "GET /fapi/v1/premiumIndex":
+		if r.query.Get("symbol") == "" {
+			cost.Weight = 10
+		}
+	case "GET /fapi/v2/ticker/price":
+		if r.query.Get("symbol") == "" {
+			cost.Weight = 2
+		}
+	case "GET /fapi/v1/ticker/bookTicker":
+		if r.query.Get("symbol") == "" {
+			cost.Weight = 5
+		}
+	case "POST /fapi/v1/batchOrders", "PUT /fapi/v1/batchOrders":
+		if n := int64(strings.Count(r.form.Get("batchOrders"), "{")); n > 0 {
+			cost.Orders = n
+		}
+	}
+	return cost, true
+}
+
+// RateLimitRules return the rate limits as rules of a common.RateLimiter
+func (e *ExchangeInfo) RateLimitRules() ([]common.RateLimitRule, error) {
+	rules := make([]common.RateLimitRule, 0, len(e.RateLimits))
+	for _, l := range e.RateLimits {
+		rule, err := common.NewRateLimitRule(l.RateLimitType, l.Interval, l.IntervalNum, l.Limit)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
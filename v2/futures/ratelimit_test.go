@@ -0,0 +1,72 @@
+package futures
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type rateLimitTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimit(t *testing.T) {
+	suite.Run(t, new(rateLimitTestSuite))
+}
+
+func (s *rateLimitTestSuite) TestRequestCost() {
+	r := s.r()
+	for _, c := range []struct {
+		method   string
+		endpoint string
+		query    url.Values
+		form     url.Values
+		cost     common.RequestCost
+		ok       bool
+	}{
+		{http.MethodGet, "/fapi/v1/exchangeInfo", nil, nil, common.RequestCost{Weight: 1}, true},
+		{http.MethodGet, "/fapi/v1/depth", nil, nil, common.RequestCost{Weight: 10}, true},
+		{http.MethodGet, "/fapi/v1/depth", url.Values{"limit": {"20"}}, nil, common.RequestCost{Weight: 2}, true},
+		{http.MethodGet, "/fapi/v1/depth", url.Values{"limit": {"1000"}}, nil, common.RequestCost{Weight: 20}, true},
+		{http.MethodGet, "/fapi/v1/klines", url.Values{"limit": {"99"}}, nil, common.RequestCost{Weight: 1}, true},
+		{http.MethodGet, "/fapi/v1/klines", url.Values{"limit": {"1500"}}, nil, common.RequestCost{Weight: 10}, true},
+		{http.MethodGet, "/fapi/v1/ticker/24hr", nil, nil, common.RequestCost{Weight: 40}, true},
+		{http.MethodGet, "/fapi/v2/account", nil, nil, common.RequestCost{Weight: 5}, true},
+		{http.MethodPost, "/fapi/v1/order", nil, nil, common.RequestCost{Weight: 0, Orders: 1}, true},
+		{http.MethodPost, "/fapi/v1/batchOrders", nil, url.Values{"batchOrders": {`[{"symbol":"BTCUSDT"},{"symbol":"ETHUSDT"}]`}},
+			common.RequestCost{Weight: 5, Orders: 2}, true},
+		{http.MethodGet, "/futures/data/basis", nil, nil, common.RequestCost{}, false},
+	} {
+		cost, ok := requestCost(&request{method: c.method, endpoint: c.endpoint, query: c.query, form: c.form})
+		r.Equal(c.ok, ok, c.endpoint)
+		r.Equal(c.cost, cost, c.endpoint)
+	}
+}
+
+func (s *rateLimitTestSuite) TestFailFast() {
+	s.mockDo([]byte(`{}`), nil)
+	s.client.RateLimiter = common.NewRateLimiter(common.RateLimitRule{
+		Type:     common.RateLimitTypeRawRequests,
+		Interval: 24 * time.Hour,
+		Limit:    0,
+	})
+	s.client.RateLimiter.FailFast = true
+	err := s.client.NewPingService().Do(newContext())
+	s.r().IsType(common.RateLimitError{}, err)
+	s.client.AssertNotCalled(s.T(), "do", anyHTTPRequest())
+}
+
+func (s *rateLimitTestSuite) TestWithUsage() {
+	s.mockDo([]byte(`{}`), nil)
+	usage := new(common.Usage)
+	err := s.client.NewPingService().Do(newContext(), WithUsage(usage))
+	r := s.r()
+	r.NoError(err)
+	r.NotNil(usage.UsedWeight)
+	r.Empty(usage.UsedWeight)
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type secType int
@@ -28,6 +30,7 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+	usage      *common.Usage
 }
 
 // setParam set param with key/value to query string
@@ -105,6 +108,13 @@ func WithHeaders(header http.Header) RequestOption {
 	}
 }
 
+// WithUsage fill usage with the rate limit usage reported by the response
+func WithUsage(usage *common.Usage) RequestOption {
+	return func(r *request) {
+		r.usage = usage
+	}
+}
+
 // WithExtraForm add extra form data of the request
 func WithExtraForm(m map[string]any) RequestOption {
 	return func(r *request) {
@@ -0,0 +1,62 @@
+package futures
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+	attempts int
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+// mockAttempts fail the first failures attempts with a network error
+func (s *retryTestSuite) mockAttempts(failures int, body string) {
+	s.attempts = 0
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		s.attempts++
+		if s.attempts <= failures {
+			return nil, &url.Error{Op: req.Method, URL: req.URL.String(), Err: errors.New("connection reset by peer")}
+		}
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: http.StatusOK,
+		}, nil
+	}
+	s.client.RetryPolicy = &common.RetryPolicy{MaxAttempts: 3, Backoff: common.ConstantBackoff(0)}
+}
+
+func (s *retryTestSuite) TestRetryKeepalive() {
+	s.mockAttempts(2, `{}`)
+	err := s.client.NewKeepaliveUserStreamService().ListenKey("dummyListenKey").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(3, s.attempts)
+}
+
+func (s *retryTestSuite) TestNoRetryCancel() {
+	s.mockAttempts(1, `{}`)
+	_, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(1).Do(newContext())
+	s.r().Error(err)
+	s.r().Equal(1, s.attempts)
+}
+
+func (s *retryTestSuite) TestRetryOrderWithClientOrderID() {
+	s.mockAttempts(1, `{"symbol":"BTCUSDT","clientOrderId":"myOrder1"}`)
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder1").Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal("myOrder1", res.ClientOrderID)
+	s.r().Equal(2, s.attempts)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
@@ -105,3 +106,14 @@ func (s *serverServiceTestSuite) TestSetServerTime() {
 	s.r().NotZero(s.client.TimeOffset)
 	s.r().EqualValues(timeOffset, s.client.TimeOffset)
 }
+
+func (s *serverServiceTestSuite) TestStartTimeSync() {
+	s.mockDo([]byte(`{"serverTime": 1499827319559}`), nil)
+	defer s.assertDo()
+
+	err := s.client.StartTimeSync(newContext(), time.Hour)
+	r := s.r()
+	r.NoError(err)
+	defer s.client.TimeSync.Stop()
+	r.InDelta(currentTimestamp()-1499827319559, s.client.TimeSync.Offset(), 1000)
+}
@@ -0,0 +1,76 @@
+package futures
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolRegistryTestSuite struct {
+	baseTestSuite
+}
+
+func TestSymbolRegistry(t *testing.T) {
+	suite.Run(t, new(symbolRegistryTestSuite))
+}
+
+func (s *symbolRegistryTestSuite) TestRefresh() {
+	data := []byte(`{
+		"symbols": [
+			{
+				"symbol": "BTCUSDT_240628",
+				"pair": "BTCUSDT",
+				"contractType": "CURRENT_QUARTER",
+				"deliveryDate": 1719561600000,
+				"status": "TRADING",
+				"marginAsset": "USDT",
+				"pricePrecision": 1,
+				"filters": [{"filterType": "LOT_SIZE", "minQty": "0.001", "maxQty": "500", "stepSize": "0.001"}]
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	registry := s.client.NewSymbolRegistry()
+	s.r().NoError(registry.Refresh(newContext()))
+
+	symbol, ok := registry.Symbol("BTCUSDT_240628")
+	s.r().True(ok)
+	s.r().Equal(1, symbol.PricePrecision)
+	s.r().Equal("0.001", symbol.LotSizeFilter().StepSize)
+	contractType, ok := registry.ContractType("BTCUSDT_240628")
+	s.r().True(ok)
+	s.r().Equal(ContractTypeCurrentQuarter, contractType)
+	deliveryTime, ok := registry.DeliveryTime("BTCUSDT_240628")
+	s.r().True(ok)
+	s.r().True(time.Date(2024, 6, 28, 8, 0, 0, 0, time.UTC).Equal(deliveryTime))
+	marginAsset, ok := registry.MarginAsset("BTCUSDT_240628")
+	s.r().True(ok)
+	s.r().Equal("USDT", marginAsset)
+	_, ok = registry.ContractType("ETHUSDT")
+	s.r().False(ok)
+}
+
+func (s *symbolRegistryTestSuite) TestChangeEvents() {
+	registry := s.client.NewSymbolRegistry()
+	var events []*SymbolChangeEvent
+	registry.OnChange = func(event *SymbolChangeEvent) {
+		events = append(events, event)
+	}
+	load := func(data string) {
+		info := new(ExchangeInfo)
+		s.r().NoError(json.Unmarshal([]byte(data), info))
+		registry.SetExchangeInfo(info)
+	}
+	load(`{"symbols": [{"symbol": "BTCUSDT", "status": "TRADING"}, {"symbol": "ETHUSDT", "status": "TRADING"}]}`)
+	load(`{"symbols": [{"symbol": "BTCUSDT", "status": "TRADING"}, {"symbol": "ETHUSDT", "status": "SETTLING"}]}`)
+	s.r().Len(events, 1)
+	s.r().Equal(SymbolChangeTypeStatus, events[0].Type)
+	s.r().Equal("ETHUSDT", events[0].Symbol)
+	s.r().Equal("SETTLING", events[0].New.Status)
+	status, ok := registry.Status("ETHUSDT")
+	s.r().True(ok)
+	s.r().Equal(SymbolStatusType("SETTLING"), status)
+}
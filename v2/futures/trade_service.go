@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // HistoricalTradesService trades
@@ -273,7 +277,7 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 		r.setParam("endTime", *s.endTime)
 	}
 	if s.fromID != nil {
-		r.setParam("fromID", *s.fromID)
+		r.setParam("fromId", *s.fromID)
 	}
 	if s.limit != nil {
 		r.setParam("limit", *s.limit)
@@ -290,6 +294,52 @@ func (s *ListAccountTradeService) Do(ctx context.Context, opts ...RequestOption)
 	return res, nil
 }
 
+// Iterate walk the trades from fromID, or by windows of 7 days from startTime
+// to endTime (default now), or from the first trade
+func (s *ListAccountTradeService) Iterate(opts ...RequestOption) *common.Iterator[*AccountTrade] {
+	limit := 1000
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	if s.orderId != nil {
+		return common.NewIterator(func(ctx context.Context) ([]*AccountTrade, bool, error) {
+			trades, err := s.Do(ctx, opts...)
+			return trades, false, err
+		})
+	}
+	if s.startTime != nil && s.fromID == nil {
+		end := time.Now().UnixMilli()
+		if s.endTime != nil {
+			end = *s.endTime
+		}
+		return common.NewTimeIterator(*s.startTime, end, 7*24*time.Hour, limit,
+			func(ctx context.Context, start, end int64) ([]*AccountTrade, error) {
+				page := *s
+				return page.StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+			},
+			func(t *AccountTrade) int64 { return t.Time },
+			func(t *AccountTrade) string { return strconv.FormatInt(t.ID, 10) })
+	}
+	var fromID int64
+	if s.fromID != nil {
+		fromID = *s.fromID
+	}
+	return common.NewIDIterator(fromID, limit, func(ctx context.Context, fromID int64) ([]*AccountTrade, error) {
+		page := *s
+		page.startTime, page.endTime = nil, nil
+		trades, err := page.FromID(fromID).Limit(limit).Do(ctx, opts...)
+		if err != nil || s.endTime == nil {
+			return trades, err
+		}
+		for i, t := range trades {
+			if t.Time > *s.endTime {
+				return trades[:i], nil
+			}
+		}
+		return trades, nil
+	}, func(t *AccountTrade) int64 { return t.ID })
+}
+
 // AccountTrade define account trade
 type AccountTrade struct {
 	Buyer           bool             `json:"buyer"`
@@ -4,9 +4,12 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // WsHandler handle raw websocket message
@@ -17,68 +20,65 @@ type ErrHandler func(err error)
 
 // WsConfig webservice configuration
 type WsConfig struct {
-	Endpoint string
-	Proxy    *string
+	Endpoint  string
+	Proxy     *string
+	Reconnect *WsReconnectConfig
+}
+
+// WsReconnectConfig enables automatic reconnection of websocket streams.
+// A dropped connection is dialed again on the same endpoint, so the stream
+// is resubscribed, and the doneC channel is only closed once the client
+// closes stopC or MaxAttempts consecutive reconnects have failed.
+type WsReconnectConfig struct {
+	// Backoff decides the delay before each reconnect attempt, common.DefaultBackoff is used if nil
+	Backoff common.BackoffPolicy
+	// MaxAttempts is the number of consecutive failed reconnects before giving up, 0 means no limit
+	MaxAttempts int
+	// OnDisconnected is called when the connection of the endpoint is lost
+	OnDisconnected func(endpoint string, err error)
+	// OnReconnecting is called before each reconnect attempt
+	OnReconnecting func(endpoint string, attempt int, delay time.Duration)
+	// OnReconnected is called once the endpoint has been dialed again
+	OnReconnected func(endpoint string)
 }
 
 func newWsConfig(endpoint string) *WsConfig {
 	return &WsConfig{
-		Endpoint: endpoint,
-		Proxy:    getWsProxyUrl(),
+		Endpoint:  endpoint,
+		Proxy:     getWsProxyUrl(),
+		Reconnect: WebsocketReconnect,
 	}
 }
 
 var wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	proxy := http.ProxyFromEnvironment
-	if cfg.Proxy != nil {
-		u, err := url.Parse(*cfg.Proxy)
-		if err != nil {
-			return nil, nil, err
-		}
-		proxy = http.ProxyURL(u)
-	}
-	Dialer := websocket.Dialer{
-		Proxy:             proxy,
-		HandshakeTimeout:  45 * time.Second,
-		EnableCompression: false,
-	}
-
-	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
+	c, err := wsDial(cfg)
 	if err != nil {
 		return nil, nil, err
 	}
-	c.SetReadLimit(655350)
 	doneC = make(chan struct{})
 	stopC = make(chan struct{})
 	go func() {
 		// This function will exit either on error from
-		// websocket.Conn.ReadMessage or when the stopC channel is
-		// closed by the client.
+		// websocket.Conn.ReadMessage, when reconnecting gives up or
+		// when the stopC channel is closed by the client.
 		defer close(doneC)
-		if WebsocketKeepalive {
-			keepAlive(c, WebsocketTimeout)
-		}
-		// Wait for the stopC channel to be closed.  We do that in a
-		// separate goroutine because ReadMessage is a blocking
-		// operation.
-		silent := false
-		go func() {
-			select {
-			case <-stopC:
-				silent = true
-			case <-doneC:
-			}
-			c.Close()
-		}()
 		for {
-			_, message, err := c.ReadMessage()
+			err := wsReadLoop(c, stopC, handler)
+			if err == nil {
+				return
+			}
+			if cfg.Reconnect == nil {
+				errHandler(err)
+				return
+			}
+			c, err = wsReconnect(cfg, stopC, err)
 			if err != nil {
-				if !silent {
-					errHandler(err)
-				}
+				errHandler(err)
+				return
+			}
+			if c == nil {
 				return
 			}
-			handler(message)
 		}
 	}()
 	return
@@ -117,6 +117,97 @@ func wsServeCommon[T any](cfg *WsConfig, combined bool, eventHandler func(*T), e
 	)
 }
 
+func wsDial(cfg *WsConfig) (*websocket.Conn, error) {
+	proxy := http.ProxyFromEnvironment
+	if cfg.Proxy != nil {
+		u, err := url.Parse(*cfg.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		proxy = http.ProxyURL(u)
+	}
+	Dialer := websocket.Dialer{
+		Proxy:             proxy,
+		HandshakeTimeout:  45 * time.Second,
+		EnableCompression: false,
+	}
+
+	c, _, err := Dialer.Dial(cfg.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	c.SetReadLimit(655350)
+	return c, nil
+}
+
+// wsReadLoop passes the messages of c to handler until the connection fails
+// or stopC is closed. It returns nil when the client stopped the stream.
+func wsReadLoop(c *websocket.Conn, stopC chan struct{}, handler WsHandler) error {
+	if WebsocketKeepalive {
+		keepAlive(c, WebsocketTimeout)
+	}
+	// Wait for the stopC channel to be closed.  We do that in a
+	// separate goroutine because ReadMessage is a blocking
+	// operation.
+	var silent int32
+	connDoneC := make(chan struct{})
+	defer close(connDoneC)
+	go func() {
+		select {
+		case <-stopC:
+			atomic.StoreInt32(&silent, 1)
+		case <-connDoneC:
+		}
+		c.Close()
+	}()
+	for {
+		_, message, err := c.ReadMessage()
+		if err != nil {
+			if atomic.LoadInt32(&silent) == 1 {
+				return nil
+			}
+			return err
+		}
+		handler(message)
+	}
+}
+
+// wsReconnect dials the endpoint of cfg again after a disconnection caused by err.
+// It returns a nil connection and error if stopC is closed while waiting.
+func wsReconnect(cfg *WsConfig, stopC chan struct{}, err error) (*websocket.Conn, error) {
+	rc := cfg.Reconnect
+	if rc.OnDisconnected != nil {
+		rc.OnDisconnected(cfg.Endpoint, err)
+	}
+	backoff := rc.Backoff
+	if backoff == nil {
+		backoff = common.DefaultBackoff
+	}
+	for attempt := 1; rc.MaxAttempts <= 0 || attempt <= rc.MaxAttempts; attempt++ {
+		delay := backoff.Backoff(attempt)
+		if rc.OnReconnecting != nil {
+			rc.OnReconnecting(cfg.Endpoint, attempt, delay)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-stopC:
+			timer.Stop()
+			return nil, nil
+		case <-timer.C:
+		}
+		var c *websocket.Conn
+		c, err = wsDial(cfg)
+		if err != nil {
+			continue
+		}
+		if rc.OnReconnected != nil {
+			rc.OnReconnected(cfg.Endpoint)
+		}
+		return c, nil
+	}
+	return nil, err
+}
+
 func keepAlive(c *websocket.Conn, timeout time.Duration) {
 	ticker := time.NewTicker(timeout)
 
@@ -0,0 +1,215 @@
+package futures
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsDiffDepthServeChan serve the diff depth stream of symbol like WsDiffDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsDiffDepthServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsDiffDepthServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsKlineServeChan serve the kline stream of symbol like WsKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsKlineServeChan(ctx context.Context, symbol string, interval string, opts ...common.ChanOption) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsAggTradeServeChan serve the aggregate trade stream of symbol like WsAggTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAggTradeServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsAggTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsAggTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAggTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsMarkPriceServeChan serve the mark price stream of symbol like WsMarkPriceServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMarkPriceServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsMarkPriceEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarkPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsBookTickerServeChan serve the book ticker stream of symbol like WsBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsBookTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsBookTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsUserDataServeChan serve the user data stream of listenKey like WsUserDataServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsUserDataServeChan(ctx context.Context, listenKey string, opts ...common.ChanOption) (<-chan *WsUserDataEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedAggTradeServeChan serve the aggregate trade streams of symbols like WsCombinedAggTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedAggTradeServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsAggTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsAggTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedAggTradeServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedMarkPriceServeChan serve the mark price streams of symbols like WsCombinedMarkPriceServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedMarkPriceServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsMarkPriceEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarkPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedMarkPriceServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllMarkPriceServeChan serve the all mark price stream like WsAllMarkPriceServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllMarkPriceServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsAllMarkPriceEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsAllMarkPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarkPriceServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedKlineServeChan serve the kline streams of symbolIntervalPair like WsCombinedKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedKlineServeChan(ctx context.Context, symbolIntervalPair map[string]string, opts ...common.ChanOption) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedKlineServe(symbolIntervalPair, handler, errHandler)
+	}, opts...)
+}
+
+// WsContinuousKlineServeChan serve the continuous kline stream of subscribeArgs like WsContinuousKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsContinuousKlineServeChan(ctx context.Context, subscribeArgs *WsContinuousKlineSubscribeArgs, opts ...common.ChanOption) (<-chan *WsContinuousKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsContinuousKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsContinuousKlineServe(subscribeArgs, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedContinuousKlineServeChan serve the continuous kline streams of subscribeArgsList like WsCombinedContinuousKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedContinuousKlineServeChan(ctx context.Context, subscribeArgsList []*WsContinuousKlineSubscribeArgs, opts ...common.ChanOption) (<-chan *WsContinuousKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsContinuousKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedContinuousKlineServe(subscribeArgsList, handler, errHandler)
+	}, opts...)
+}
+
+// WsMiniMarketTickerServeChan serve the mini market ticker stream of symbol like WsMiniMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMiniMarketTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsMiniMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMiniMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMiniMarketTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllMiniMarketTickerServeChan serve the all mini market ticker stream like WsAllMiniMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllMiniMarketTickerServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsAllMiniMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsAllMiniMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllMiniMarketTickerServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsMarketTickerServeChan serve the market ticker stream of symbol like WsMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMarketTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarketTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllMarketTickerServeChan serve the all market ticker stream like WsAllMarketTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllMarketTickerServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsAllMarketTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsAllMarketTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarketTickerServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedBookTickerServeChan serve the book ticker streams of symbols like WsCombinedBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedBookTickerServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedBookTickerServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllBookTickerServeChan serve the all book ticker stream like WsAllBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllBookTickerServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllBookTickerServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsLiquidationOrderServeChan serve the liquidation order stream of symbol like WsLiquidationOrderServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsLiquidationOrderServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsLiquidationOrderEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsLiquidationOrderEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsLiquidationOrderServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllLiquidationOrderServeChan serve the all liquidation order stream like WsAllLiquidationOrderServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllLiquidationOrderServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan *WsLiquidationOrderEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsLiquidationOrderEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllLiquidationOrderServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsPartialDepthServeChan serve the partial depth stream of symbol like WsPartialDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsPartialDepthServeChan(ctx context.Context, symbol string, levels string, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedPartialDepthServeChan serve the partial depth streams of symbolLevelsRates like WsCombinedPartialDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedPartialDepthServeChan(ctx context.Context, symbolLevelsRates [][]string, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedPartialDepthServe(symbolLevelsRates, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedDiffDepthServeChan serve the diff depth streams of symbolRates like WsCombinedDiffDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedDiffDepthServeChan(ctx context.Context, symbolRates map[string]string, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedDiffDepthServe(symbolRates, handler, errHandler)
+	}, opts...)
+}
+
+// WsBLVTInfoServeChan serve the BLVT info stream of name like WsBLVTInfoServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsBLVTInfoServeChan(ctx context.Context, name string, opts ...common.ChanOption) (<-chan *WsBLVTInfoEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBLVTInfoEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsBLVTInfoServe(name, handler, errHandler)
+	}, opts...)
+}
+
+// WsBLVTKlineServeChan serve the BLVT kline stream of name like WsBLVTKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsBLVTKlineServeChan(ctx context.Context, name string, interval string, opts ...common.ChanOption) (<-chan *WsBLVTKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBLVTKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsBLVTKlineServe(name, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsCompositiveIndexServeChan serve the composite index stream of symbol like WsCompositiveIndexServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCompositiveIndexServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsCompositeIndexEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsCompositeIndexEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCompositiveIndexServe(symbol, handler, errHandler)
+	}, opts...)
+}
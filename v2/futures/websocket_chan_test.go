@@ -0,0 +1,64 @@
+package futures
+
+import (
+	"context"
+	"errors"
+)
+
+func (s *websocketServiceTestSuite) TestAggTradeServeChan() {
+	s.mockWsServe([]byte(`{
+		"e": "aggTrade",
+		"E": 123456789,
+		"s": "BTCUSDT",
+		"a": 5933014,
+		"p": "0.001",
+		"q": "100",
+		"f": 100,
+		"l": 105,
+		"T": 123456785,
+		"m": true
+	}`), errors.New("fake error"))
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs, err := WsAggTradeServeChan(ctx, "BTCUSDT")
+	s.r().NoError(err)
+	event := <-events
+	s.Equal("BTCUSDT", event.Symbol)
+	s.Equal(int64(5933014), event.AggregateTradeID)
+	s.EqualError(<-errs, "fake error")
+	cancel()
+	_, ok := <-events
+	s.False(ok)
+	_, ok = <-errs
+	s.False(ok)
+}
+
+func (s *websocketServiceTestSuite) TestCombinedAggTradeServeChan() {
+	s.mockWsServe([]byte(`{
+		"stream": "ethusdt@aggTrade",
+		"data": {
+			"e": "aggTrade",
+			"E": 123456789,
+			"s": "ETHUSDT",
+			"a": 5933014,
+			"p": "0.001",
+			"q": "100",
+			"f": 100,
+			"l": 105,
+			"T": 123456785,
+			"m": true
+		}
+	}`), nil)
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _, err := WsCombinedAggTradeServeChan(ctx, []string{"BTCUSDT", "ETHUSDT"})
+	s.r().NoError(err)
+	event := <-events
+	s.Equal("ETHUSDT", event.Symbol)
+	s.Equal("0.001", event.Price)
+	cancel()
+	_, ok := <-events
+	s.False(ok)
+}
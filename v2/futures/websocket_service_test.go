@@ -1841,6 +1841,77 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdate() {
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOrderTradeUpdateAmendment() {
+	data := []byte(`{
+		"e":"ORDER_TRADE_UPDATE",
+		"E":1629184560899,
+		"T":1629184560898,
+		"o":{
+		  "s":"BTCUSDT",
+		  "c":"LJ9R4QZDihCaS8UAOOLpgW",
+		  "S":"BUY",
+		  "o":"LIMIT",
+		  "f":"GTC",
+		  "q":"1",
+		  "p":"30003.2",
+		  "ap":"0",
+		  "sp":"0",
+		  "x":"AMENDMENT",
+		  "X":"NEW",
+		  "i":20072994037,
+		  "l":"0",
+		  "z":"0",
+		  "L":"0",
+		  "T":1629184560898,
+		  "t":0,
+		  "b":"30003.2",
+		  "a":"0",
+		  "m":false,
+		  "R":false,
+		  "wt":"CONTRACT_PRICE",
+		  "ot":"LIMIT",
+		  "ps":"LONG",
+		  "cp":false,
+		  "rp":"0",
+		  "V":"NONE",
+		  "pm":"NONE",
+		  "gtd":0
+		}
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event:           "ORDER_TRADE_UPDATE",
+		Time:            1629184560899,
+		TransactionTime: 1629184560898,
+		OrderTradeUpdate: WsOrderTradeUpdate{
+			Symbol:               "BTCUSDT",
+			ClientOrderID:        "LJ9R4QZDihCaS8UAOOLpgW",
+			Side:                 SideTypeBuy,
+			Type:                 OrderTypeLimit,
+			TimeInForce:          TimeInForceTypeGTC,
+			OriginalQty:          "1",
+			OriginalPrice:        "30003.2",
+			AveragePrice:         "0",
+			StopPrice:            "0",
+			ExecutionType:        OrderExecutionTypeAmendment,
+			Status:               OrderStatusTypeNew,
+			ID:                   20072994037,
+			LastFilledQty:        "0",
+			AccumulatedFilledQty: "0",
+			LastFilledPrice:      "0",
+			TradeTime:            1629184560898,
+			BidsNotional:         "30003.2",
+			AsksNotional:         "0",
+			WorkingType:          WorkingTypeContractPrice,
+			OriginalType:         OrderTypeLimit,
+			PositionSide:         PositionSideTypeLong,
+			RealizedPnL:          "0",
+			STP:                  "NONE",
+			PriceMode:            "NONE",
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
 func (s *websocketServiceTestSuite) TestWsUserDataServeAccountConfigUpdate() {
 	data := []byte(`{
 		"e":"ACCOUNT_CONFIG_UPDATE",
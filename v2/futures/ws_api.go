@@ -0,0 +1,451 @@
+package futures
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Endpoints
+const (
+	baseWsAPIMainURL    = "wss://ws-fapi.binance.com/ws-fapi/v1"
+	baseWsAPITestnetURL = "wss://testnet.binancefuture.com/ws-fapi/v1"
+)
+
+// ErrWsAPINotConnected is returned when a request is sent before Connect or after the connection is lost
+var ErrWsAPINotConnected = common.ErrWsAPINotConnected
+
+// getWsAPIEndpoint return the base endpoint of the WS API according the UseTestnet flag
+func getWsAPIEndpoint() string {
+	if UseTestnet {
+		return baseWsAPITestnetURL
+	}
+	return baseWsAPIMainURL
+}
+
+// WsAPIClient define a client of the futures websocket API, requests are sent over one
+// persistent connection and matched to their responses by id.
+// Services will be created by the form client.NewXXXService().
+type WsAPIClient struct {
+	*common.WsAPIConn[WsAPIRateLimit]
+}
+
+// WsAPIResponse define a response of the websocket API
+type WsAPIResponse = common.WsAPIResponse[WsAPIRateLimit]
+
+// WsAPIRateLimit define the usage of a rate limit returned with a websocket API response
+type WsAPIRateLimit struct {
+	RateLimitType string `json:"rateLimitType"`
+	Interval      string `json:"interval"`
+	IntervalNum   int64  `json:"intervalNum"`
+	Limit         int64  `json:"limit"`
+	Count         int64  `json:"count"`
+}
+
+// WsAPISessionStatus define the status of an authenticated websocket API session
+type WsAPISessionStatus = common.WsAPISessionStatus
+
+// NewWsAPIClient initialize a websocket API client instance with API key and secret key.
+// Call Connect before sending requests.
+func NewWsAPIClient(apiKey, secretKey string) *WsAPIClient {
+	return &WsAPIClient{common.NewWsAPIConn[WsAPIRateLimit](apiKey, secretKey, getWsAPIEndpoint(), wsAPIDial)}
+}
+
+func wsAPIDial(endpoint string) (*websocket.Conn, error) {
+	return wsDial(newWsConfig(endpoint))
+}
+
+// Call send a request with the given method and parameters and wait for its response.
+// Signed requests are authenticated with the session if SessionLogon succeeded,
+// otherwise they carry the API key and a signature.
+func (c *WsAPIClient) Call(ctx context.Context, method string, parameters map[string]interface{}, signed bool, opts ...RequestOption) (*WsAPIResponse, error) {
+	st := secTypeNone
+	if signed {
+		st = secTypeSigned
+	}
+	return c.call(ctx, method, parameters, st, opts...)
+}
+
+func (c *WsAPIClient) call(ctx context.Context, method string, m params, st secType, opts ...RequestOption) (*WsAPIResponse, error) {
+	r := newWsAPIRequest(st, opts...)
+	return c.Send(ctx, method, m, wsAPISecType(st), r.recvWindow)
+}
+
+func (c *WsAPIClient) callAPI(ctx context.Context, method string, m params, st secType, v interface{}, opts ...RequestOption) error {
+	res, err := c.call(ctx, method, m, st, opts...)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(res.Result, v)
+}
+
+func newWsAPIRequest(st secType, opts ...RequestOption) *request {
+	r := &request{secType: st}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func wsAPISecType(st secType) common.WsAPISecType {
+	switch st {
+	case secTypeAPIKey:
+		return common.WsAPISecTypeAPIKey
+	case secTypeSigned:
+		return common.WsAPISecTypeSigned
+	}
+	return common.WsAPISecTypeNone
+}
+
+// SessionLogon authenticate the connection with an Ed25519 key, later signed
+// requests don't need to carry the API key and signature
+func (c *WsAPIClient) SessionLogon(ctx context.Context, opts ...RequestOption) (res *WsAPISessionStatus, err error) {
+	return c.Logon(ctx, newWsAPIRequest(secTypeSigned, opts...).recvWindow)
+}
+
+// SessionStatus query the status of the websocket API session
+func (c *WsAPIClient) SessionStatus(ctx context.Context, opts ...RequestOption) (res *WsAPISessionStatus, err error) {
+	return c.Status(ctx, newWsAPIRequest(secTypeNone, opts...).recvWindow)
+}
+
+// SessionLogout forget the API key of the session, the connection stays open
+func (c *WsAPIClient) SessionLogout(ctx context.Context, opts ...RequestOption) (res *WsAPISessionStatus, err error) {
+	return c.Logout(ctx, newWsAPIRequest(secTypeNone, opts...).recvWindow)
+}
+
+// NewCreateOrderService init creating order service
+func (c *WsAPIClient) NewCreateOrderService() *WsCreateOrderService {
+	return &WsCreateOrderService{c: c}
+}
+
+// NewModifyOrderService init modifying order service
+func (c *WsAPIClient) NewModifyOrderService() *WsModifyOrderService {
+	return &WsModifyOrderService{c: c}
+}
+
+// NewCancelOrderService init cancel order service
+func (c *WsAPIClient) NewCancelOrderService() *WsCancelOrderService {
+	return &WsCancelOrderService{c: c}
+}
+
+// NewGetOrderService init get order service
+func (c *WsAPIClient) NewGetOrderService() *WsGetOrderService {
+	return &WsGetOrderService{c: c}
+}
+
+// NewGetPositionRiskService init getting position risk service
+func (c *WsAPIClient) NewGetPositionRiskService() *WsGetPositionRiskService {
+	return &WsGetPositionRiskService{c: c}
+}
+
+// NewGetAccountService init getting account service
+func (c *WsAPIClient) NewGetAccountService() *WsGetAccountService {
+	return &WsGetAccountService{c: c}
+}
+
+// NewGetBalanceService init getting balance service
+func (c *WsAPIClient) NewGetBalanceService() *WsGetBalanceService {
+	return &WsGetBalanceService{c: c}
+}
+
+// WsCreateOrderService create order through the websocket API, it takes the same parameters as CreateOrderService
+type WsCreateOrderService struct {
+	c *WsAPIClient
+	s CreateOrderService
+}
+
+// Symbol set symbol
+func (s *WsCreateOrderService) Symbol(symbol string) *WsCreateOrderService {
+	s.s.Symbol(symbol)
+	return s
+}
+
+// Side set side
+func (s *WsCreateOrderService) Side(side SideType) *WsCreateOrderService {
+	s.s.Side(side)
+	return s
+}
+
+// PositionSide set side
+func (s *WsCreateOrderService) PositionSide(positionSide PositionSideType) *WsCreateOrderService {
+	s.s.PositionSide(positionSide)
+	return s
+}
+
+// Type set type
+func (s *WsCreateOrderService) Type(orderType OrderType) *WsCreateOrderService {
+	s.s.Type(orderType)
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *WsCreateOrderService) TimeInForce(timeInForce TimeInForceType) *WsCreateOrderService {
+	s.s.TimeInForce(timeInForce)
+	return s
+}
+
+// Quantity set quantity
+func (s *WsCreateOrderService) Quantity(quantity string) *WsCreateOrderService {
+	s.s.Quantity(quantity)
+	return s
+}
+
+// ReduceOnly set reduceOnly
+func (s *WsCreateOrderService) ReduceOnly(reduceOnly bool) *WsCreateOrderService {
+	s.s.ReduceOnly(reduceOnly)
+	return s
+}
+
+// Price set price
+func (s *WsCreateOrderService) Price(price string) *WsCreateOrderService {
+	s.s.Price(price)
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *WsCreateOrderService) NewClientOrderID(newClientOrderID string) *WsCreateOrderService {
+	s.s.NewClientOrderID(newClientOrderID)
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *WsCreateOrderService) StopPrice(stopPrice string) *WsCreateOrderService {
+	s.s.StopPrice(stopPrice)
+	return s
+}
+
+// WorkingType set workingType
+func (s *WsCreateOrderService) WorkingType(workingType WorkingType) *WsCreateOrderService {
+	s.s.WorkingType(workingType)
+	return s
+}
+
+// ActivationPrice set activationPrice
+func (s *WsCreateOrderService) ActivationPrice(activationPrice string) *WsCreateOrderService {
+	s.s.ActivationPrice(activationPrice)
+	return s
+}
+
+// CallbackRate set callbackRate
+func (s *WsCreateOrderService) CallbackRate(callbackRate string) *WsCreateOrderService {
+	s.s.CallbackRate(callbackRate)
+	return s
+}
+
+// PriceProtect set priceProtect
+func (s *WsCreateOrderService) PriceProtect(priceProtect bool) *WsCreateOrderService {
+	s.s.PriceProtect(priceProtect)
+	return s
+}
+
+// NewOrderResponseType set newOrderResponseType
+func (s *WsCreateOrderService) NewOrderResponseType(newOrderResponseType NewOrderRespType) *WsCreateOrderService {
+	s.s.NewOrderResponseType(newOrderResponseType)
+	return s
+}
+
+// ClosePosition set closePosition
+func (s *WsCreateOrderService) ClosePosition(closePosition bool) *WsCreateOrderService {
+	s.s.ClosePosition(closePosition)
+	return s
+}
+
+// Do send request
+func (s *WsCreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	m := s.s.buildParams()
+	if s.s.newOrderRespType == "" {
+		delete(m, "newOrderRespType")
+	}
+	res = new(CreateOrderResponse)
+	err = s.c.callAPI(ctx, "order.place", m, secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WsModifyOrderService modify the price or quantity of a limit order through the websocket API,
+// it takes the same parameters as ModifyOrderService
+type WsModifyOrderService struct {
+	c *WsAPIClient
+	s ModifyOrderService
+}
+
+// Symbol set symbol
+func (s *WsModifyOrderService) Symbol(symbol string) *WsModifyOrderService {
+	s.s.Symbol(symbol)
+	return s
+}
+
+// OrderID set orderID
+func (s *WsModifyOrderService) OrderID(orderID int64) *WsModifyOrderService {
+	s.s.OrderID(orderID)
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *WsModifyOrderService) OrigClientOrderID(origClientOrderID string) *WsModifyOrderService {
+	s.s.OrigClientOrderID(origClientOrderID)
+	return s
+}
+
+// Side set side
+func (s *WsModifyOrderService) Side(side SideType) *WsModifyOrderService {
+	s.s.Side(side)
+	return s
+}
+
+// Quantity set quantity
+func (s *WsModifyOrderService) Quantity(quantity string) *WsModifyOrderService {
+	s.s.Quantity(quantity)
+	return s
+}
+
+// Price set price
+func (s *WsModifyOrderService) Price(price string) *WsModifyOrderService {
+	s.s.Price(price)
+	return s
+}
+
+// PriceMatch set priceMatch, it can't be passed together with price
+func (s *WsModifyOrderService) PriceMatch(priceMatch PriceMatchType) *WsModifyOrderService {
+	s.s.PriceMatch(priceMatch)
+	return s
+}
+
+// Do send request
+func (s *WsModifyOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	res = new(Order)
+	err = s.c.callAPI(ctx, "order.modify", s.s.buildParams(), secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WsCancelOrderService cancel an order through the websocket API
+type WsCancelOrderService struct {
+	c *WsAPIClient
+	s CancelOrderService
+}
+
+// Symbol set symbol
+func (s *WsCancelOrderService) Symbol(symbol string) *WsCancelOrderService {
+	s.s.Symbol(symbol)
+	return s
+}
+
+// OrderID set orderID
+func (s *WsCancelOrderService) OrderID(orderID int64) *WsCancelOrderService {
+	s.s.OrderID(orderID)
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *WsCancelOrderService) OrigClientOrderID(origClientOrderID string) *WsCancelOrderService {
+	s.s.OrigClientOrderID(origClientOrderID)
+	return s
+}
+
+// Do send request
+func (s *WsCancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	res = new(CancelOrderResponse)
+	err = s.c.callAPI(ctx, "order.cancel", s.s.buildParams(), secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WsGetOrderService get an order through the websocket API
+type WsGetOrderService struct {
+	c *WsAPIClient
+	s GetOrderService
+}
+
+// Symbol set symbol
+func (s *WsGetOrderService) Symbol(symbol string) *WsGetOrderService {
+	s.s.Symbol(symbol)
+	return s
+}
+
+// OrderID set orderID
+func (s *WsGetOrderService) OrderID(orderID int64) *WsGetOrderService {
+	s.s.OrderID(orderID)
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *WsGetOrderService) OrigClientOrderID(origClientOrderID string) *WsGetOrderService {
+	s.s.OrigClientOrderID(origClientOrderID)
+	return s
+}
+
+// Do send request
+func (s *WsGetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	res = new(Order)
+	err = s.c.callAPI(ctx, "order.status", s.s.buildParams(), secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WsGetPositionRiskService get position information through the websocket API
+type WsGetPositionRiskService struct {
+	c      *WsAPIClient
+	symbol string
+}
+
+// Symbol set symbol
+func (s *WsGetPositionRiskService) Symbol(symbol string) *WsGetPositionRiskService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *WsGetPositionRiskService) Do(ctx context.Context, opts ...RequestOption) (res []*PositionRisk, err error) {
+	m := params{}
+	if s.symbol != "" {
+		m["symbol"] = s.symbol
+	}
+	res = make([]*PositionRisk, 0)
+	err = s.c.callAPI(ctx, "account.position", m, secTypeSigned, &res, opts...)
+	if err != nil {
+		return []*PositionRisk{}, err
+	}
+	return res, nil
+}
+
+// WsGetAccountService get account info through the websocket API
+type WsGetAccountService struct {
+	c *WsAPIClient
+}
+
+// Do send request
+func (s *WsGetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	res = new(Account)
+	err = s.c.callAPI(ctx, "account.status", params{}, secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WsGetBalanceService get account balance through the websocket API
+type WsGetBalanceService struct {
+	c *WsAPIClient
+}
+
+// Do send request
+func (s *WsGetBalanceService) Do(ctx context.Context, opts ...RequestOption) (res []*Balance, err error) {
+	res = make([]*Balance, 0)
+	err = s.c.callAPI(ctx, "account.balance", params{}, secTypeSigned, &res, opts...)
+	if err != nil {
+		return []*Balance{}, err
+	}
+	return res, nil
+}
@@ -0,0 +1,341 @@
+package futures
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type wsAPIServerRequest struct {
+	ID     string                 `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params"`
+}
+
+// wsAPIServer is a local stand-in of the websocket API, respond builds the
+// response payload of each request
+type wsAPIServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*wsAPIServerRequest
+	respond  func(conn *websocket.Conn, req *wsAPIServerRequest)
+}
+
+func newWsAPIServer() *wsAPIServer {
+	s := &wsAPIServer{}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			req := new(wsAPIServerRequest)
+			if err := conn.ReadJSON(req); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.requests = append(s.requests, req)
+			s.mu.Unlock()
+			s.respond(conn, req)
+		}
+	}))
+	return s
+}
+
+func (s *wsAPIServer) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func (s *wsAPIServer) lastRequest() *wsAPIServerRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests[len(s.requests)-1]
+}
+
+func writeWsAPIResult(conn *websocket.Conn, id string, result string) {
+	conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"id":"%s","status":200,"result":%s}`, id, result)))
+}
+
+type wsAPITestSuite struct {
+	suite.Suite
+	server *wsAPIServer
+	client *WsAPIClient
+}
+
+func TestWsAPIClient(t *testing.T) {
+	suite.Run(t, new(wsAPITestSuite))
+}
+
+func (s *wsAPITestSuite) SetupTest() {
+	s.server = newWsAPIServer()
+	s.client = NewWsAPIClient("dummyAPIKey", "dummySecretKey")
+	s.client.Endpoint = s.server.endpoint()
+}
+
+func (s *wsAPITestSuite) TearDownTest() {
+	s.client.Close()
+	s.server.Close()
+}
+
+func (s *wsAPITestSuite) TestCreateOrder() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		writeWsAPIResult(conn, req.ID, `{
+			"orderId": 325078477,
+			"symbol": "BTCUSDT",
+			"status": "NEW",
+			"clientOrderId": "iCXL1BywlBaf2sesNUrVl3",
+			"price": "43187.00",
+			"avgPrice": "0.00",
+			"origQty": "0.100",
+			"executedQty": "0.000",
+			"cumQty": "0.000",
+			"cumQuote": "0.00000",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"reduceOnly": false,
+			"closePosition": false,
+			"side": "BUY",
+			"positionSide": "BOTH",
+			"stopPrice": "0.00",
+			"workingType": "CONTRACT_PRICE",
+			"priceProtect": false,
+			"origType": "LIMIT",
+			"priceMatch": "NONE",
+			"selfTradePreventionMode": "NONE",
+			"goodTillDate": 0,
+			"updateTime": 1702555534435
+		}`)
+	}
+	r.NoError(s.client.Connect())
+
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Price("43187.00").
+		Quantity("0.1").PositionSide(PositionSideTypeBoth).Do(newContext())
+	r.NoError(err)
+	r.Equal(&CreateOrderResponse{
+		Symbol:                  "BTCUSDT",
+		OrderID:                 325078477,
+		ClientOrderID:           "iCXL1BywlBaf2sesNUrVl3",
+		Price:                   "43187.00",
+		OrigQuantity:            "0.100",
+		ExecutedQuantity:        "0.000",
+		CumQuote:                "0.00000",
+		Status:                  OrderStatusTypeNew,
+		StopPrice:               "0.00",
+		TimeInForce:             TimeInForceTypeGTC,
+		Type:                    OrderTypeLimit,
+		Side:                    SideTypeBuy,
+		UpdateTime:              1702555534435,
+		WorkingType:             WorkingTypeContractPrice,
+		AvgPrice:                "0.00",
+		PositionSide:            PositionSideTypeBoth,
+		PriceMatch:              "NONE",
+		SelfTradePreventionMode: STPModeTypeNone,
+		CumQty:                  "0.000",
+		OrigType:                OrderTypeLimit,
+	}, res)
+
+	req := s.server.lastRequest()
+	r.Equal("order.place", req.Method)
+	r.Equal("BTCUSDT", req.Params["symbol"])
+	r.Equal("BUY", req.Params["side"])
+	r.Equal("LIMIT", req.Params["type"])
+	r.Equal("GTC", req.Params["timeInForce"])
+	r.Equal("43187.00", req.Params["price"])
+	r.Equal("0.1", req.Params["quantity"])
+	r.Equal("BOTH", req.Params["positionSide"])
+	r.NotContains(req.Params, "newOrderRespType")
+	r.Equal("dummyAPIKey", req.Params["apiKey"])
+	r.NotEmpty(req.Params["timestamp"])
+	r.NotEmpty(req.Params["signature"])
+}
+
+func (s *wsAPITestSuite) TestModifyOrder() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		writeWsAPIResult(conn, req.ID, `{
+			"orderId": 328971409,
+			"symbol": "BTCUSDT",
+			"status": "NEW",
+			"price": "43769.10",
+			"origQty": "0.110",
+			"side": "SELL",
+			"priceMatch": "OPPONENT"
+		}`)
+	}
+	r.NoError(s.client.Connect())
+
+	res, err := s.client.NewModifyOrderService().Symbol("BTCUSDT").OrderID(328971409).
+		Side(SideTypeSell).Quantity("0.110").PriceMatch(PriceMatchTypeOpponent).Do(newContext())
+	r.NoError(err)
+	r.Equal(int64(328971409), res.OrderID)
+	r.Equal("0.110", res.OrigQuantity)
+	r.Equal("OPPONENT", res.PriceMatch)
+
+	req := s.server.lastRequest()
+	r.Equal("order.modify", req.Method)
+	r.EqualValues(328971409, req.Params["orderId"])
+	r.Equal("SELL", req.Params["side"])
+	r.Equal("0.110", req.Params["quantity"])
+	r.Equal("OPPONENT", req.Params["priceMatch"])
+	r.NotContains(req.Params, "price")
+}
+
+func (s *wsAPITestSuite) TestCancelOrder() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{
+			"id": "%s",
+			"status": 400,
+			"error": {"code": -2011, "msg": "Unknown order sent."}
+		}`, req.ID)))
+	}
+	r.NoError(s.client.Connect())
+
+	_, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrigClientOrderID("myOrder1").Do(newContext())
+	r.Equal(&common.APIError{Code: -2011, Message: "Unknown order sent.", StatusCode: 400}, err)
+
+	req := s.server.lastRequest()
+	r.Equal("order.cancel", req.Method)
+	r.Equal("myOrder1", req.Params["origClientOrderId"])
+}
+
+func (s *wsAPITestSuite) TestConcurrentRequests() {
+	r := s.Require()
+	var mu sync.Mutex
+	var held []*wsAPIServerRequest
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		mu.Lock()
+		defer mu.Unlock()
+		held = append(held, req)
+		if len(held) < 3 {
+			return
+		}
+		// answer in reverse order
+		for i := len(held) - 1; i >= 0; i-- {
+			orderID := held[i].Params["orderId"]
+			writeWsAPIResult(conn, held[i].ID, fmt.Sprintf(`{"symbol": "BTCUSDT", "orderId": %v, "status": "FILLED"}`, orderID))
+		}
+	}
+	r.NoError(s.client.Connect())
+
+	var wg sync.WaitGroup
+	results := make([]*Order, 3)
+	errs := make([]error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(int64(i + 1)).Do(newContext())
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < 3; i++ {
+		r.NoError(errs[i])
+		r.Equal(int64(i+1), results[i].OrderID)
+		r.Equal(OrderStatusTypeFilled, results[i].Status)
+	}
+}
+
+func (s *wsAPITestSuite) TestGetPositionRisk() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		writeWsAPIResult(conn, req.ID, `[{
+			"entryPrice": "0.00000",
+			"breakEvenPrice": "0.0",
+			"marginType": "isolated",
+			"isAutoAddMargin": "false",
+			"isolatedMargin": "0.00000000",
+			"leverage": "10",
+			"liquidationPrice": "0",
+			"markPrice": "6679.50671178",
+			"maxNotionalValue": "20000000",
+			"positionAmt": "0.000",
+			"notional": "0",
+			"isolatedWallet": "0",
+			"symbol": "BTCUSDT",
+			"unRealizedProfit": "0.00000000",
+			"positionSide": "BOTH"
+		}]`)
+	}
+	r.NoError(s.client.Connect())
+
+	res, err := s.client.NewGetPositionRiskService().Symbol("BTCUSDT").Do(newContext())
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal(&PositionRisk{
+		EntryPrice:       "0.00000",
+		BreakEvenPrice:   "0.0",
+		MarginType:       "isolated",
+		IsAutoAddMargin:  "false",
+		IsolatedMargin:   "0.00000000",
+		Leverage:         "10",
+		LiquidationPrice: "0",
+		MarkPrice:        "6679.50671178",
+		MaxNotionalValue: "20000000",
+		PositionAmt:      "0.000",
+		Notional:         "0",
+		IsolatedWallet:   "0",
+		Symbol:           "BTCUSDT",
+		UnRealizedProfit: "0.00000000",
+		PositionSide:     "BOTH",
+	}, res[0])
+	r.Equal("account.position", s.server.lastRequest().Method)
+}
+
+func (s *wsAPITestSuite) TestGetAccount() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		writeWsAPIResult(conn, req.ID, `{
+			"feeTier": 0,
+			"canTrade": true,
+			"totalWalletBalance": "103.12345678",
+			"availableBalance": "103.12345678",
+			"assets": [{"asset": "USDT", "walletBalance": "23.72469206"}],
+			"positions": [{"symbol": "BTCUSDT", "positionAmt": "1.000", "positionSide": "BOTH"}]
+		}`)
+	}
+	r.NoError(s.client.Connect())
+
+	res, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	r.True(res.CanTrade)
+	r.Equal("103.12345678", res.TotalWalletBalance)
+	r.Equal("23.72469206", res.Assets[0].WalletBalance)
+	r.Equal("1.000", res.Positions[0].PositionAmt)
+	r.Equal("account.status", s.server.lastRequest().Method)
+}
+
+func (s *wsAPITestSuite) TestGetBalance() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		writeWsAPIResult(conn, req.ID, `[{
+			"accountAlias": "SgsR",
+			"asset": "USDT",
+			"balance": "122607.35137903",
+			"crossWalletBalance": "23.72469206",
+			"crossUnPnl": "0.00000000",
+			"availableBalance": "23.72469206",
+			"maxWithdrawAmount": "23.72469206"
+		}]`)
+	}
+	r.NoError(s.client.Connect())
+
+	res, err := s.client.NewGetBalanceService().Do(newContext())
+	r.NoError(err)
+	r.Len(res, 1)
+	r.Equal("USDT", res[0].Asset)
+	r.Equal("122607.35137903", res[0].Balance)
+	r.Equal("account.balance", s.server.lastRequest().Method)
+}
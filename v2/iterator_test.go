@@ -0,0 +1,103 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type iteratorTestSuite struct {
+	baseTestSuite
+	queries []url.Values
+}
+
+func TestIterator(t *testing.T) {
+	suite.Run(t, new(iteratorTestSuite))
+}
+
+// mockPages answer the requests with the pages in turn and record their queries
+func (s *iteratorTestSuite) mockPages(pages ...string) {
+	s.queries = nil
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		query.Del(timestampKey)
+		query.Del(signatureKey)
+		s.queries = append(s.queries, query)
+		return newHTTPResponse([]byte(pages[len(s.queries)-1]), http.StatusOK), nil
+	}
+}
+
+func (s *iteratorTestSuite) TestListTradesFromID() {
+	s.mockPages(
+		`[{"id": 10, "time": 1000}, {"id": 11, "time": 2000}]`,
+		`[{"id": 12, "time": 3000}, {"id": 13, "time": 4000}]`,
+		`[]`,
+	)
+	it := s.client.NewListTradesService().Symbol("BTCUSDT").FromID(10).Limit(2).Iterate()
+	var ids []int64
+	for it.Next(newContext()) {
+		ids = append(ids, it.Value().ID)
+	}
+	s.r().NoError(it.Err())
+	s.r().Equal([]int64{10, 11, 12, 13}, ids)
+	s.r().Len(s.queries, 3)
+	s.r().Equal("10", s.queries[0].Get("fromId"))
+	s.r().Equal("12", s.queries[1].Get("fromId"))
+	s.r().Equal("14", s.queries[2].Get("fromId"))
+	s.r().Equal("2", s.queries[2].Get("limit"))
+}
+
+func (s *iteratorTestSuite) TestListTradesEndTime() {
+	s.mockPages(`[{"id": 10, "time": 1000}, {"id": 11, "time": 2000}]`)
+	trades, err := s.client.NewListTradesService().Symbol("BTCUSDT").FromID(10).EndTime(1500).Limit(2).
+		Iterate().All(newContext())
+	s.r().NoError(err)
+	s.r().Len(trades, 1)
+	s.r().Len(s.queries, 1)
+	s.r().Empty(s.queries[0].Get("endTime"))
+}
+
+func (s *iteratorTestSuite) TestListOrdersTimeWindows() {
+	const day = 24 * 60 * 60 * 1000
+	s.mockPages(
+		`[{"orderId": 1, "time": 1000}]`,
+		`[{"orderId": 2, "time": 86401000}]`,
+	)
+	orders, err := s.client.NewListOrdersService().Symbol("BTCUSDT").StartTime(0).EndTime(2*day - 1).
+		Iterate().All(newContext())
+	s.r().NoError(err)
+	s.r().Len(orders, 2)
+	s.r().Len(s.queries, 2)
+	s.r().Equal("0", s.queries[0].Get("startTime"))
+	s.r().Equal("86399999", s.queries[0].Get("endTime"))
+	s.r().Equal("86400000", s.queries[1].Get("startTime"))
+	s.r().Equal("172799999", s.queries[1].Get("endTime"))
+}
+
+func (s *iteratorTestSuite) TestListDepositsOffset() {
+	s.mockPages(
+		`[{"txId": "a"}, {"txId": "b"}]`,
+		`[{"txId": "c"}]`,
+	)
+	deposits, err := s.client.NewListDepositsService().Coin("BTC").Limit(2).Iterate().All(newContext())
+	s.r().NoError(err)
+	s.r().Len(deposits, 3)
+	s.r().Equal("0", s.queries[0].Get("offset"))
+	s.r().Equal("2", s.queries[1].Get("offset"))
+	s.r().Empty(s.queries[1].Get("startTime"))
+}
+
+func (s *iteratorTestSuite) TestCancel() {
+	s.mockPages(`[{"a": 1}, {"a": 2}]`, `[{"a": 3}]`)
+	ctx, cancel := context.WithCancel(newContext())
+	it := s.client.NewAggTradesService().Symbol("BTCUSDT").FromID(1).Limit(2).Iterate()
+	s.r().True(it.Next(ctx))
+	s.r().True(it.Next(ctx))
+	cancel()
+	s.r().False(it.Next(ctx))
+	s.r().ErrorIs(it.Err(), context.Canceled)
+	s.r().Len(s.queries, 1)
+}
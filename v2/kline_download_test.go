@@ -0,0 +1,84 @@
+package binance
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type klineDownloadTestSuite struct {
+	baseTestSuite
+}
+
+func TestKlineDownload(t *testing.T) {
+	suite.Run(t, new(klineDownloadTestSuite))
+}
+
+// mockKlines answer the klines requests with a kline per minute of
+// [startTime, endTime], except the missing ones
+func (s *klineDownloadTestSuite) mockKlines(missing ...int64) *[]string {
+	var mu sync.Mutex
+	var paths []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		start, _ := strconv.ParseInt(query.Get("startTime"), 10, 64)
+		end, _ := strconv.ParseInt(query.Get("endTime"), 10, 64)
+		mu.Lock()
+		paths = append(paths, req.URL.Path)
+		mu.Unlock()
+		var klines []string
+	next:
+		for t := start; t <= end; t += 60000 {
+			for _, m := range missing {
+				if t == m {
+					continue next
+				}
+			}
+			klines = append(klines, fmt.Sprintf(`[%d, "1.0", "2.0", "0.5", "1.5", "10", %d, "15", 3, "5", "7.5", "0"]`, t, t+59999))
+		}
+		return newHTTPResponse([]byte("["+strings.Join(klines, ",")+"]"), http.StatusOK), nil
+	}
+	return &paths
+}
+
+func (s *klineDownloadTestSuite) TestDownload() {
+	paths := s.mockKlines(180000)
+	res, err := s.client.NewKlineDownloadService().Symbol("BTCUSDT").Interval("1m").
+		StartTime(0).EndTime(300000).Limit(2).Concurrency(2).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(*paths, 3)
+	r.Equal("/api/v3/klines", (*paths)[0])
+	r.Len(res.Klines, 4)
+	for i, openTime := range []int64{0, 60000, 120000, 240000} {
+		r.Equal(openTime, res.Klines[i].OpenTime)
+	}
+	r.Equal([]common.KlineGap{{Start: 180000, End: 240000}}, res.Gaps)
+
+	var buf bytes.Buffer
+	r.NoError(res.WriteCSV(&buf))
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	r.Len(lines, 5)
+	r.Equal("openTime,open,high,low,close,volume,closeTime,quoteAssetVolume,tradeNum,takerBuyBaseAssetVolume,takerBuyQuoteAssetVolume", lines[0])
+	r.Equal("0,1.0,2.0,0.5,1.5,10,59999,15,3,5,7.5", lines[1])
+
+	buf.Reset()
+	r.NoError(res.WriteNDJSON(&buf))
+	lines = strings.Split(strings.TrimSpace(buf.String()), "\n")
+	r.Len(lines, 4)
+	r.Contains(lines[3], `"openTime":240000`)
+}
+
+func (s *klineDownloadTestSuite) TestDownloadError() {
+	s.mockDo([]byte(`{"code": -1121, "msg": "Invalid symbol."}`), nil, http.StatusBadRequest)
+	_, err := s.client.NewKlineDownloadService().Symbol("BTCUSDT").Interval("1m").
+		StartTime(0).EndTime(300000).Do(newContext())
+	s.r().Error(err)
+}
@@ -7,20 +7,21 @@ import (
 
 // CreateMarginOrderService create order
 type CreateMarginOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	orderType        OrderType
-	quantity         *string
-	quoteOrderQty    *string
-	price            *string
-	stopPrice        *string
-	newClientOrderID *string
-	icebergQuantity  *string
-	newOrderRespType *NewOrderRespType
-	sideEffectType   *SideEffectType
-	timeInForce      *TimeInForceType
-	isIsolated       *bool
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	stopPrice               *string
+	newClientOrderID        *string
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	sideEffectType          *SideEffectType
+	timeInForce             *TimeInForceType
+	isIsolated              *bool
+	selfTradePreventionMode *STPModeType
 }
 
 // Symbol set symbol
@@ -101,6 +102,12 @@ func (s *CreateMarginOrderService) SideEffectType(sideEffectType SideEffectType)
 	return s
 }
 
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateMarginOrderService) SelfTradePreventionMode(mode STPModeType) *CreateMarginOrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
 // Do send request
 func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
 	r := &request{
@@ -147,6 +154,9 @@ func (s *CreateMarginOrderService) Do(ctx context.Context, opts ...RequestOption
 	if s.sideEffectType != nil {
 		m["sideEffectType"] = *s.sideEffectType
 	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
 	r.setFormParams(m)
 	res = new(CreateOrderResponse)
 	data, err := s.c.callAPI(ctx, r, opts...)
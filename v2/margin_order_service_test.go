@@ -75,6 +75,48 @@ func (s *marginOrderServiceTestSuite) TestCreateOrder() {
 	s.assertCreateOrderResponseEqual(e, res)
 }
 
+func (s *marginOrderServiceTestSuite) TestCreateOrderSTP() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 1,
+		"clientOrderId": "myOrder1",
+		"transactTime": 1499827319559,
+		"price": "0.0001",
+		"origQty": "12.00",
+		"executedQty": "0.00",
+		"cummulativeQuoteQty": "0.00",
+		"status": "EXPIRED_IN_MATCH",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "BUY",
+		"isIsolated": true,
+		"selfTradePreventionMode": "EXPIRE_BOTH"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "LTCBTC",
+			"side":                    SideTypeBuy,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTC,
+			"quantity":                "12.00",
+			"price":                   "0.0001",
+			"isIsolated":              "TRUE",
+			"selfTradePreventionMode": STPModeTypeExpireBoth,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateMarginOrderService().Symbol("LTCBTC").Side(SideTypeBuy).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("12.00").Price("0.0001").
+		IsIsolated(true).SelfTradePreventionMode(STPModeTypeExpireBoth).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(OrderStatusExpiredInMatch, res.Status)
+	r.Equal(STPModeTypeExpireBoth, res.SelfTradePreventionMode)
+	r.True(res.IsIsolated)
+}
+
 func (s *marginOrderServiceTestSuite) TestCreateOrderFull() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
@@ -250,7 +250,13 @@ type Client struct {
 	Debug      bool
 	Logger     *log.Logger
 	TimeOffset int64
-	do         doFunc
+	// RateLimiter limits the requests before they are sent, nil disables it
+	RateLimiter *common.RateLimiter
+	// RetryPolicy sends again the requests failing with a transient error, nil disables it
+	RetryPolicy *common.RetryPolicy
+	// TimeSync replaces TimeOffset to sign requests when it is set
+	TimeSync *common.TimeSync
+	do       doFunc
 }
 
 func (c *Client) debug(format string, v ...interface{}) {
@@ -274,7 +280,7 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 		r.setParam(recvWindowKey, r.recvWindow)
 	}
 	if r.secType == secTypeSigned {
-		r.setParam(timestampKey, currentTimestamp()-c.TimeOffset)
+		r.setParam(timestampKey, currentTimestamp()-c.timeOffset())
 	}
 	queryString := r.query.Encode()
 	body := &bytes.Buffer{}
@@ -323,14 +329,63 @@ func (c *Client) parseRequest(r *request, opts ...RequestOption) (err error) {
 	return nil
 }
 
+// timeOffset return the offset to sign requests with
+func (c *Client) timeOffset() int64 {
+	if c.TimeSync != nil {
+		return c.TimeSync.Offset()
+	}
+	return c.TimeOffset
+}
+
+// resyncTime resync TimeSync when a signed request was rejected for its
+// timestamp, it tells if the request can be sent again
+func (c *Client) resyncTime(ctx context.Context, r *request, err error) bool {
+	if c.TimeSync == nil || r.secType != secTypeSigned || !common.IsTimestampOutOfRecvWindow(err) {
+		return false
+	}
+	_, err = c.TimeSync.Resync(ctx)
+	return err == nil
+}
+
+// callAPI send r, requests failing with a transient error are sent again
+// according to RetryPolicy when it is safe to do so, and signed requests
+// rejected for their timestamp are sent again once after resyncing TimeSync
 func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption) (data []byte, header *http.Header, err error) {
+	resynced := false
+	for attempt := 1; ; attempt++ {
+		var statusCode int
+		data, statusCode, header, err = c.send(ctx, r, opts...)
+		if err == nil {
+			return data, header, err
+		}
+		// the options were applied to r by the first attempt
+		opts = nil
+		if !resynced && c.resyncTime(ctx, r, err) {
+			resynced = true
+			continue
+		}
+		if c.RetryPolicy == nil || !retryable(r) {
+			return data, header, err
+		}
+		delay, ok := c.RetryPolicy.Delay(attempt, err, statusCode, *header)
+		if !ok {
+			return data, header, err
+		}
+		c.debug("attempt %d failed, retry in %s: %s\n", attempt, delay, err)
+		if err = common.Sleep(ctx, delay); err != nil {
+			return []byte{}, &http.Header{}, err
+		}
+	}
+}
+
+func (c *Client) send(ctx context.Context, r *request, opts ...RequestOption) (data []byte, statusCode int, header *http.Header, err error) {
 	err = c.parseRequest(r, opts...)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	req, err := http.NewRequest(r.method, r.fullURL, r.body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	req = req.WithContext(ctx)
 	req.Header = r.header
@@ -339,13 +394,26 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 	if f == nil {
 		f = c.HTTPClient.Do
 	}
+	if c.RateLimiter != nil {
+		if cost, ok := requestCost(r); ok {
+			if err = c.RateLimiter.Wait(ctx, cost); err != nil {
+				return []byte{}, 0, &http.Header{}, err
+			}
+		}
+	}
 	res, err := f(req)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
+	}
+	if c.RateLimiter != nil {
+		c.RateLimiter.Update(res.Header)
+	}
+	if r.usage != nil {
+		*r.usage = *common.ParseUsage(res.Header)
 	}
 	data, err = ioutil.ReadAll(res.Body)
 	if err != nil {
-		return []byte{}, &http.Header{}, err
+		return []byte{}, 0, &http.Header{}, err
 	}
 	defer func() {
 		cerr := res.Body.Close()
@@ -368,9 +436,11 @@ func (c *Client) callAPI(ctx context.Context, r *request, opts ...RequestOption)
 		if !apiErr.IsValid() {
 			apiErr.Response = data
 		}
-		return nil, &res.Header, apiErr
+		apiErr.StatusCode = res.StatusCode
+		apiErr.RetryAfter, _ = common.ParseRetryAfter(res.Header)
+		return nil, res.StatusCode, &res.Header, apiErr
 	}
-	return data, &res.Header, nil
+	return data, res.StatusCode, &res.Header, nil
 }
 
 // SetApiEndpoint set api Endpoint
@@ -0,0 +1,71 @@
+package options
+
+import "github.com/adshao/go-binance/v2/common"
+
+// Decimal accessors of the string amounts of responses, invalid or empty
+// strings are 0. Use common.ParseDecimal to check the value.
+
+// PriceDecimal return Price as a decimal
+func (o *Order) PriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.Price)
+}
+
+// QuantityDecimal return Quantity as a decimal
+func (o *Order) QuantityDecimal() common.Decimal {
+	return common.DecimalOrZero(o.Quantity)
+}
+
+// ExecutedQtyDecimal return ExecutedQty as a decimal
+func (o *Order) ExecutedQtyDecimal() common.Decimal {
+	return common.DecimalOrZero(o.ExecutedQty)
+}
+
+// FeeDecimal return Fee as a decimal
+func (o *Order) FeeDecimal() common.Decimal {
+	return common.DecimalOrZero(o.Fee)
+}
+
+// AvgPriceDecimal return AvgPrice as a decimal
+func (o *Order) AvgPriceDecimal() common.Decimal {
+	return common.DecimalOrZero(o.AvgPrice)
+}
+
+// OpenDecimal return Open as a decimal
+func (k *Kline) OpenDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Open)
+}
+
+// HighDecimal return High as a decimal
+func (k *Kline) HighDecimal() common.Decimal {
+	return common.DecimalOrZero(k.High)
+}
+
+// LowDecimal return Low as a decimal
+func (k *Kline) LowDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Low)
+}
+
+// CloseDecimal return Close as a decimal
+func (k *Kline) CloseDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Close)
+}
+
+// VolumeDecimal return Volume as a decimal
+func (k *Kline) VolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Volume)
+}
+
+// AmountDecimal return Amount as a decimal
+func (k *Kline) AmountDecimal() common.Decimal {
+	return common.DecimalOrZero(k.Amount)
+}
+
+// TakerVolumeDecimal return TakerVolume as a decimal
+func (k *Kline) TakerVolumeDecimal() common.Decimal {
+	return common.DecimalOrZero(k.TakerVolume)
+}
+
+// TakerAmountDecimal return TakerAmount as a decimal
+func (k *Kline) TakerAmountDecimal() common.Decimal {
+	return common.DecimalOrZero(k.TakerAmount)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/adshao/go-binance/v2/common"
@@ -56,6 +57,11 @@ func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
 	return s
 }
 
+// QuantityDecimal set quantity
+func (s *CreateOrderService) QuantityDecimal(quantity common.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
 // ReduceOnly set reduceOnly
 func (s *CreateOrderService) ReduceOnly(reduceOnly bool) *CreateOrderService {
 	s.reduceOnly = &reduceOnly
@@ -74,6 +80,11 @@ func (s *CreateOrderService) Price(price string) *CreateOrderService {
 	return s
 }
 
+// PriceDecimal set price
+func (s *CreateOrderService) PriceDecimal(price common.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
 // ClientOrderId set clientOrderId
 func (s *CreateOrderService) ClientOrderId(ClientOrderId string) *CreateOrderService {
 	s.clientOrderId = &ClientOrderId
@@ -437,8 +448,20 @@ func (s *CancelBatchOrdersService) ClientOrderIds(clientOrderIds []string) *Canc
 	return s
 }
 
-// return: [Order, APIError]
-func (s *CancelBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []interface{}, err error) {
+// CancelBatchOrdersResponse contains the response from CancelBatchOrders operation
+type CancelBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were canceled successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was canceled successfully OR a non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// Do send request
+func (s *CancelBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CancelBatchOrdersResponse, err error) {
 	r := &request{
 		method:   http.MethodDelete,
 		endpoint: "/eapi/v1/batchOrders",
@@ -467,42 +490,56 @@ func (s *CancelBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 	rawMessages := make([]*json.RawMessage, 0)
 	err = json.Unmarshal(data, &rawMessages)
 	if err != nil {
-		return []interface{}{}, err
+		return nil, err
 	}
 
-	res = make([]interface{}, 0)
-	for _, j := range rawMessages {
+	res = &CancelBatchOrdersResponse{N: len(rawMessages), Errors: make([]error, len(rawMessages))}
+	for i, j := range rawMessages {
 		e := new(common.APIError)
 		if err := json.Unmarshal(*j, e); err != nil {
-			return []interface{}{}, err
+			return nil, err
 		}
 		if e.IsValid() {
-			res = append(res, *e)
+			res.Errors[i] = e
 			continue
 		}
 		o := new(Order)
 		if err := json.Unmarshal(*j, o); err != nil {
-			return []interface{}{}, err
+			return nil, err
 		}
 		o.RateLimitOrder10s = rlos
 		o.RateLimitOrder1m = rlom
-		res = append(res, *o)
+		res.Orders = append(res.Orders, o)
 	}
 	return res, nil
 }
 
+// CreateBatchOrdersService place a list of orders
 type CreateBatchOrdersService struct {
 	c      *Client
 	orders []*CreateOrderService
 }
 
+// OrderList set the orders to place
 func (s *CreateBatchOrdersService) OrderList(orders []*CreateOrderService) *CreateBatchOrdersService {
 	s.orders = orders
 	return s
 }
 
-// return: [Order, APIError]
-func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []interface{}, err error) {
+// CreateBatchOrdersResponse contains the response from CreateBatchOrders operation
+type CreateBatchOrdersResponse struct {
+	// Total number of messages in the response
+	N int
+	// List of orders which were placed successfully which can have a length between 0 and N
+	Orders []*Order
+	// List of errors of length N, where each item corresponds to a nil value if
+	// the order from that specific index was placed successfully OR a non-nil *APIError if there was an error with
+	// the order at that index
+	Errors []error
+}
+
+// Do send request
+func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption) (res *CreateBatchOrdersResponse, err error) {
 	r := &request{
 		method:   http.MethodPost,
 		endpoint: "/eapi/v1/batchOrders",
@@ -513,7 +550,7 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 	for _, order := range s.orders {
 		if order.newOrderRespType != "" && order.newOrderRespType != NewOrderRespTypeACK &&
 			order.newOrderRespType != NewOrderRespTypeRESULT {
-			return []interface{}{}, fmt.Errorf("no expected newOrderRespType value=%v", order.newOrderRespType)
+			return nil, fmt.Errorf("no expected newOrderRespType value=%v", order.newOrderRespType)
 		}
 		m := params{
 			"symbol":   order.symbol,
@@ -547,7 +584,7 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 
 	b, err := json.Marshal(orders)
 	if err != nil {
-		return []interface{}{}, err
+		return nil, err
 	}
 	m := params{
 		"orders": string(b),
@@ -557,30 +594,30 @@ func (s *CreateBatchOrdersService) Do(ctx context.Context, opts ...RequestOption
 
 	data, _, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
-		return []interface{}{}, err
+		return nil, err
 	}
 
 	rawMessages := make([]*json.RawMessage, 0)
 	err = json.Unmarshal(data, &rawMessages)
 	if err != nil {
-		return []interface{}{}, err
+		return nil, err
 	}
 
-	res = make([]interface{}, 0)
-	for _, j := range rawMessages {
+	res = &CreateBatchOrdersResponse{N: len(rawMessages), Errors: make([]error, len(rawMessages))}
+	for i, j := range rawMessages {
 		e := new(common.APIError)
 		if err := json.Unmarshal(*j, e); err != nil {
-			return []interface{}{}, err
+			return nil, err
 		}
 		if e.IsValid() {
-			res = append(res, *e)
+			res.Errors[i] = e
 			continue
 		}
 		o := new(Order)
 		if err := json.Unmarshal(*j, o); err != nil {
-			return []interface{}{}, err
+			return nil, err
 		}
-		res = append(res, *o)
+		res.Orders = append(res.Orders, o)
 	}
 	return res, nil
 }
@@ -982,6 +1019,9 @@ func (s *BillService) Do(ctx context.Context, opts ...RequestOption) (res []*Bil
 	m := params{
 		"currency": s.currency,
 	}
+	if s.recordId != nil {
+		m["recordId"] = *s.recordId
+	}
 	if s.startTime != nil {
 		m["startTime"] = *s.startTime
 	}
@@ -1007,6 +1047,25 @@ func (s *BillService) Do(ctx context.Context, opts ...RequestOption) (res []*Bil
 	return res, nil
 }
 
+// Iterate walk the bills from recordId, or from the first bill
+func (s *BillService) Iterate(opts ...RequestOption) *common.Iterator[*Bill] {
+	limit := 1000
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	var fromID int64
+	if s.recordId != nil {
+		fromID = int64(*s.recordId)
+	}
+	return common.NewIDIterator(fromID, limit, func(ctx context.Context, fromID int64) ([]*Bill, error) {
+		page := *s
+		return page.RecordId(uint64(fromID)).Limit(limit).Do(ctx, opts...)
+	}, func(b *Bill) int64 {
+		id, _ := strconv.ParseInt(b.Id, 10, 64)
+		return id
+	})
+}
+
 type IncomeDownloadIdService struct {
 	c         *Client
 	startTime uint64 // timestamp ms
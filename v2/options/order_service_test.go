@@ -62,20 +62,26 @@ func (s *baseOrderTestSuite) assertOrdersEqual(e, a []*Order) {
 	}
 }
 
-func (s *baseOrderTestSuite) assertOrderAndAPIErrorListEqual(e, a []interface{}) {
+// assertOrderAndAPIErrorListEqual check the orders and errors of a batch response against e,
+// a list of the expected Order or common.APIError at each index
+func (s *baseOrderTestSuite) assertOrderAndAPIErrorListEqual(e []interface{}, orders []*Order, errs []error) {
+	s.r().Len(errs, len(e))
+	n := 0
 	for i := range e {
 		switch ee := e[i].(type) {
 		case Order:
-			aa, ok := a[i].(Order)
-			s.r().Equal(true, ok, "convert Order failed")
-			s.assertOrderEqual(&ee, &aa)
+			s.r().NoError(errs[i])
+			s.r().Greater(len(orders), n, "missing Order")
+			s.assertOrderEqual(&ee, orders[n])
+			n++
 		case common.APIError:
-			aa, ok := a[i].(common.APIError)
+			aa, ok := errs[i].(*common.APIError)
 			s.r().Equal(true, ok, "convert APIError failed")
 			s.r().Equal(ee.Code, aa.Code, "Code")
 			s.r().Equal(ee.Message, aa.Message, "Message")
 		}
 	}
+	s.r().Len(orders, n)
 }
 
 func (s *orderServiceTestSuite) TestCreateOrder() {
@@ -305,7 +311,7 @@ func (s *orderServiceTestSuite) TestCreateBatchOrders() {
 	returnOrders, err := s.client.NewCreateBatchOrdersService().OrderList(orderLists).Do(newContext())
 	r := s.r()
 	r.NoError(err)
-	r.Len(returnOrders, 3)
+	r.Equal(3, returnOrders.N)
 
 	orders := []interface{}{
 		Order{
@@ -361,7 +367,7 @@ func (s *orderServiceTestSuite) TestCreateBatchOrders() {
 			Message: "test 1002",
 		},
 	}
-	s.assertOrderAndAPIErrorListEqual(orders, returnOrders)
+	s.assertOrderAndAPIErrorListEqual(orders, returnOrders.Orders, returnOrders.Errors)
 }
 
 func (s *orderServiceTestSuite) TestGetOrder() {
@@ -639,7 +645,8 @@ func (s *orderServiceTestSuite) TestCancelBatchOrders() {
 			Message: "test 1002",
 		},
 	}
-	s.assertOrderAndAPIErrorListEqual(e, res)
+	s.r().Equal(3, res.N)
+	s.assertOrderAndAPIErrorListEqual(e, res.Orders, res.Errors)
 }
 
 func (s *orderServiceTestSuite) TestCancelAllOpenOrders() {
@@ -0,0 +1,82 @@
+package options
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the weight of the /eapi endpoints which do not weigh 1
+var requestWeights = map[string]int64{
+	"GET /eapi/v1/trades":           5,
+	"GET /eapi/v1/historicalTrades": 20,
+	"GET /eapi/v1/mark":             5,
+	"GET /eapi/v1/ticker":           5,
+	"GET /eapi/v1/exerciseHistory":  3,
+	"GET /eapi/v1/account":          3,
+	"GET /eapi/v1/position":         5,
+	"GET /eapi/v1/historyOrders":    3,
+	"GET /eapi/v1/userTrades":       5,
+	"GET /eapi/v1/exerciseRecord":   5,
+	"POST /eapi/v1/order":           0,
+	"POST /eapi/v1/batchOrders":     5,
+}
+
+// orderCounts define the orders counted against the ORDERS limits by the endpoints placing orders
+var orderCounts = map[string]int64{
+	"POST /eapi/v1/order":       1,
+	"POST /eapi/v1/batchOrders": 10,
+}
+
+// requestCost return what r counts against the /eapi limits, false if r is
+// not counted against them
+func requestCost(r *request) (common.RequestCost, bool) {
+	if !strings.HasPrefix(r.endpoint, "/eapi/") {
+		return common.RequestCost{}, false
+	}
+	key := r.method + " " + r.endpoint
+	cost := common.RequestCost{Weight: 1, Orders: orderCounts[key]}
+	if w, ok := requestWeights[key]; ok {
+		cost.Weight = w
+	}
+	switch key {
+	case "GET /eapi/v1/depth":
+		limit, err := strconv.Atoi(r.query.Get("limit"))
+		if err != nil {
+			limit = 100
+		}
+		switch {
+		case limit > 500:
+			cost.Weight = 20
+		case limit > 100:
+			cost.Weight = 10
+		case limit > 50:
+			cost.Weight = 5
+		default:
+			cost.Weight = 2
+		}
+	case "GET /eapi/v1/openOrders":
+		if r.query.Get("symbol") == "" {
+			cost.Weight = 40
+		}
+	case "POST /eapi/v1/batchOrders":
+		if n := int64(strings.Count(r.form.Get("orders"), "{")); n > 0 {
+			cost.Orders = n
+		}
+	}
+	return cost, true
+}
+
+// RateLimitRules return the rate limits as rules of a common.RateLimiter
+func (e *ExchangeInfo) RateLimitRules() ([]common.RateLimitRule, error) {
+	rules := make([]common.RateLimitRule, 0, len(e.RateLimits))
+	for _, l := range e.RateLimits {
+		rule, err := common.NewRateLimitRule(l.RateLimitType, l.Interval, l.IntervalNum, l.Limit)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -5,6 +5,8 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type secType int
@@ -28,6 +30,7 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+	usage      *common.Usage
 }
 
 // setParam set param with key/value to query string
@@ -104,3 +107,10 @@ func WithHeaders(header http.Header) RequestOption {
 		r.header = header.Clone()
 	}
 }
+
+// WithUsage fill usage with the rate limit usage reported by the response
+func WithUsage(usage *common.Usage) RequestOption {
+	return func(r *request) {
+		r.usage = usage
+	}
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // PingService ping server
@@ -48,3 +51,17 @@ func (s *ServerTimeService) Do(ctx context.Context, opts ...RequestOption) (serv
 	serverTime = j.Get("serverTime").MustInt64()
 	return serverTime, nil
 }
+
+// StartTimeSync sync the time offset with the server time now then every interval
+// in the background until ctx is done or c.TimeSync.Stop is called
+func (c *Client) StartTimeSync(ctx context.Context, interval time.Duration) error {
+	ts := common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return c.NewServerTimeService().Do(ctx)
+	})
+	ts.Interval = interval
+	if err := ts.Start(ctx); err != nil {
+		return err
+	}
+	c.TimeSync = ts
+	return nil
+}
@@ -0,0 +1,88 @@
+package options
+
+import (
+	"context"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsTradeServeChan serve the trade stream of symbol like WsTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsTradeServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsIndexServeChan serve the index stream of symbol like WsIndexServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsIndexServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsIndexEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsIndexEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsIndexServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsMarkPriceServeChan serve the mark price stream of symbol like WsMarkPriceServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMarkPriceServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan []*WsMarkPriceEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event []*WsMarkPriceEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarkPriceServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsKlineServeChan serve the kline stream of symbol like WsKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsKlineServeChan(ctx context.Context, symbol string, interval string, opts ...common.ChanOption) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsTickerServeChan serve the ticker stream of symbol like WsTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan []*WsTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event []*WsTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsTickerWithExpireServeChan serve the ticker with expire stream of underlying like WsTickerWithExpireServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsTickerWithExpireServeChan(ctx context.Context, underlying string, expireDate string, opts ...common.ChanOption) (<-chan []*WsTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event []*WsTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsTickerWithExpireServe(underlying, expireDate, handler, errHandler)
+	}, opts...)
+}
+
+// WsOpenInterestServeChan serve the open interest stream of underlying like WsOpenInterestServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsOpenInterestServeChan(ctx context.Context, underlying string, expireDate string, opts ...common.ChanOption) (<-chan []*WsOpenInterestEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event []*WsOpenInterestEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsOpenInterestServe(underlying, expireDate, handler, errHandler)
+	}, opts...)
+}
+
+// WsOptionPairServeChan serve the option pair stream like WsOptionPairServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsOptionPairServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan *WsOptionPairEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsOptionPairEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsOptionPairServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsDepthServeChan serve the depth stream of symbol like WsDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsDepthServeChan(ctx context.Context, symbol string, levels string, rate *time.Duration, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsDepthServe(symbol, levels, rate, handler, errHandler)
+	}, opts...)
+}
+
+// WsUserDataServeChan serve the user data stream of listenKey like WsUserDataServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsUserDataServeChan(ctx context.Context, listenKey string, opts ...common.ChanOption) (<-chan *WsUserDataEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
@@ -0,0 +1,29 @@
+package options
+
+import (
+	"context"
+	"errors"
+)
+
+func (s *websocketServiceTestSuite) TestIndexServeChan() {
+	s.mockWsServe([]byte(`{
+		"e": "index",
+		"E": 1716883243048,
+		"s": "ETHUSDT",
+		"p": "3846.63204545"
+	}`), errors.New("fake error"))
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs, err := WsIndexServeChan(ctx, "ETHUSDT")
+	s.r().NoError(err)
+	event := <-events
+	s.Equal("ETHUSDT", event.Symbol)
+	s.Equal("3846.63204545", event.Price)
+	s.EqualError(<-errs, "fake error")
+	cancel()
+	_, ok := <-events
+	s.False(ok)
+	_, ok = <-errs
+	s.False(ok)
+}
@@ -21,6 +21,8 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect enables reconnecting dropped streams with the given policy, nil disables it
+	WebsocketReconnect *WsReconnectConfig
 	// UseTestnet switch all the WS streams from production to the testnet
 	UseTestnet = false
 
@@ -769,8 +771,11 @@ type WsOrderTradeUpdate struct {
 type WsUserDataHandler func(event *WsUserDataEvent)
 
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)), handler, errHandler)
+}
+
+// wsUserDataServe serve the user data stream with cfg
+func wsUserDataServe(cfg *WsConfig, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
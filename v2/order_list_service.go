@@ -0,0 +1,389 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// OrderListLeg define an order of an order list, the parameters are sent with
+// the prefix of its position in the list, such as above, below, working or pending
+type OrderListLeg struct {
+	orderType     OrderType
+	side          *SideType
+	quantity      *string
+	clientOrderID *string
+	price         *string
+	stopPrice     *string
+	trailingDelta *int64
+	icebergQty    *string
+	timeInForce   *TimeInForceType
+	strategyID    *int64
+	strategyType  *int64
+}
+
+// NewOrderListLeg init an order of an order list with its type
+func NewOrderListLeg(orderType OrderType) *OrderListLeg {
+	return &OrderListLeg{orderType: orderType}
+}
+
+// Side set side, only used by the working and pending orders of OTO lists and the working order of OTOCO lists
+func (l *OrderListLeg) Side(side SideType) *OrderListLeg {
+	l.side = &side
+	return l
+}
+
+// Quantity set quantity, only used by the working and pending orders of OTO lists and the working order of OTOCO lists
+func (l *OrderListLeg) Quantity(quantity string) *OrderListLeg {
+	l.quantity = &quantity
+	return l
+}
+
+// ClientOrderID set clientOrderId
+func (l *OrderListLeg) ClientOrderID(clientOrderID string) *OrderListLeg {
+	l.clientOrderID = &clientOrderID
+	return l
+}
+
+// Price set price
+func (l *OrderListLeg) Price(price string) *OrderListLeg {
+	l.price = &price
+	return l
+}
+
+// StopPrice set stopPrice
+func (l *OrderListLeg) StopPrice(stopPrice string) *OrderListLeg {
+	l.stopPrice = &stopPrice
+	return l
+}
+
+// TrailingDelta set trailingDelta
+func (l *OrderListLeg) TrailingDelta(trailingDelta int64) *OrderListLeg {
+	l.trailingDelta = &trailingDelta
+	return l
+}
+
+// IcebergQty set icebergQty
+func (l *OrderListLeg) IcebergQty(icebergQty string) *OrderListLeg {
+	l.icebergQty = &icebergQty
+	return l
+}
+
+// TimeInForce set timeInForce
+func (l *OrderListLeg) TimeInForce(timeInForce TimeInForceType) *OrderListLeg {
+	l.timeInForce = &timeInForce
+	return l
+}
+
+// StrategyID set strategyId
+func (l *OrderListLeg) StrategyID(strategyID int64) *OrderListLeg {
+	l.strategyID = &strategyID
+	return l
+}
+
+// StrategyType set strategyType, it must be at least 1000000
+func (l *OrderListLeg) StrategyType(strategyType int64) *OrderListLeg {
+	l.strategyType = &strategyType
+	return l
+}
+
+// setParams set the parameters of the leg with prefix into m
+func (l *OrderListLeg) setParams(m params, prefix string) {
+	if l == nil {
+		return
+	}
+	m[prefix+"Type"] = l.orderType
+	if l.side != nil {
+		m[prefix+"Side"] = *l.side
+	}
+	if l.quantity != nil {
+		m[prefix+"Quantity"] = *l.quantity
+	}
+	if l.clientOrderID != nil {
+		m[prefix+"ClientOrderId"] = *l.clientOrderID
+	}
+	if l.price != nil {
+		m[prefix+"Price"] = *l.price
+	}
+	if l.stopPrice != nil {
+		m[prefix+"StopPrice"] = *l.stopPrice
+	}
+	if l.trailingDelta != nil {
+		m[prefix+"TrailingDelta"] = *l.trailingDelta
+	}
+	if l.icebergQty != nil {
+		m[prefix+"IcebergQty"] = *l.icebergQty
+	}
+	if l.timeInForce != nil {
+		m[prefix+"TimeInForce"] = *l.timeInForce
+	}
+	if l.strategyID != nil {
+		m[prefix+"StrategyId"] = *l.strategyID
+	}
+	if l.strategyType != nil {
+		m[prefix+"StrategyType"] = *l.strategyType
+	}
+}
+
+// orderList hold the parameters shared by all order lists
+type orderList struct {
+	listClientOrderID       *string
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *STPModeType
+}
+
+func (o *orderList) setParams(m params) {
+	if o.listClientOrderID != nil {
+		m["listClientOrderId"] = *o.listClientOrderID
+	}
+	if o.newOrderRespType != nil {
+		m["newOrderRespType"] = *o.newOrderRespType
+	}
+	if o.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *o.selfTradePreventionMode
+	}
+}
+
+func createOrderList(ctx context.Context, c *Client, endpoint string, m params, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(m)
+	data, err := c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateOrderListResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// CreateOrderListResponse define create order list response
+type CreateOrderListResponse struct {
+	OrderListID       int64               `json:"orderListId"`
+	ContingencyType   ContingencyType     `json:"contingencyType"`
+	ListStatusType    ListStatusType      `json:"listStatusType"`
+	ListOrderStatus   ListOrderStatusType `json:"listOrderStatus"`
+	ListClientOrderID string              `json:"listClientOrderId"`
+	TransactionTime   int64               `json:"transactionTime"`
+	Symbol            string              `json:"symbol"`
+	Orders            []*OCOOrder         `json:"orders"`
+	OrderReports      []*OCOOrderReport   `json:"orderReports"`
+}
+
+// CreateOrderListOCOService create an OCO order list, one of the orders is
+// above the current price and the other one below
+type CreateOrderListOCOService struct {
+	c *Client
+	orderList
+	symbol   string
+	side     SideType
+	quantity string
+	above    *OrderListLeg
+	below    *OrderListLeg
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOCOService) Symbol(symbol string) *CreateOrderListOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateOrderListOCOService) Side(side SideType) *CreateOrderListOCOService {
+	s.side = side
+	return s
+}
+
+// Quantity set quantity of both orders
+func (s *CreateOrderListOCOService) Quantity(quantity string) *CreateOrderListOCOService {
+	s.quantity = quantity
+	return s
+}
+
+// Above set the order above the current price, of type STOP_LOSS_LIMIT,
+// STOP_LOSS, LIMIT_MAKER, TAKE_PROFIT or TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) Above(above *OrderListLeg) *CreateOrderListOCOService {
+	s.above = above
+	return s
+}
+
+// Below set the order below the current price, of type STOP_LOSS,
+// STOP_LOSS_LIMIT, TAKE_PROFIT or TAKE_PROFIT_LIMIT
+func (s *CreateOrderListOCOService) Below(below *OrderListLeg) *CreateOrderListOCOService {
+	s.below = below
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderListOCOService) SelfTradePreventionMode(mode STPModeType) *CreateOrderListOCOService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"quantity": s.quantity,
+	}
+	s.above.setParams(m, "above")
+	s.below.setParams(m, "below")
+	s.orderList.setParams(m)
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oco", m, opts...)
+}
+
+// CreateOrderListOTOService create an OTO order list, the pending order is
+// placed once the working order is fully filled
+type CreateOrderListOTOService struct {
+	c *Client
+	orderList
+	symbol  string
+	working *OrderListLeg
+	pending *OrderListLeg
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOService) Symbol(symbol string) *CreateOrderListOTOService {
+	s.symbol = symbol
+	return s
+}
+
+// Working set the working order, of type LIMIT or LIMIT_MAKER, its side, quantity and price are mandatory
+func (s *CreateOrderListOTOService) Working(working *OrderListLeg) *CreateOrderListOTOService {
+	s.working = working
+	return s
+}
+
+// Pending set the pending order, its side and quantity are mandatory
+func (s *CreateOrderListOTOService) Pending(pending *OrderListLeg) *CreateOrderListOTOService {
+	s.pending = pending
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOTOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderListOTOService) SelfTradePreventionMode(mode STPModeType) *CreateOrderListOTOService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol": s.symbol,
+	}
+	s.working.setParams(m, "working")
+	s.pending.setParams(m, "pending")
+	s.orderList.setParams(m)
+	return createOrderList(ctx, s.c, "/api/v3/orderList/oto", m, opts...)
+}
+
+// CreateOrderListOTOCOService create an OTOCO order list, the pending OCO
+// orders are placed once the working order is fully filled
+type CreateOrderListOTOCOService struct {
+	c *Client
+	orderList
+	symbol          string
+	working         *OrderListLeg
+	pendingSide     SideType
+	pendingQuantity string
+	pendingAbove    *OrderListLeg
+	pendingBelow    *OrderListLeg
+}
+
+// Symbol set symbol
+func (s *CreateOrderListOTOCOService) Symbol(symbol string) *CreateOrderListOTOCOService {
+	s.symbol = symbol
+	return s
+}
+
+// Working set the working order, of type LIMIT or LIMIT_MAKER, its side, quantity and price are mandatory
+func (s *CreateOrderListOTOCOService) Working(working *OrderListLeg) *CreateOrderListOTOCOService {
+	s.working = working
+	return s
+}
+
+// PendingSide set pendingSide of both pending orders
+func (s *CreateOrderListOTOCOService) PendingSide(side SideType) *CreateOrderListOTOCOService {
+	s.pendingSide = side
+	return s
+}
+
+// PendingQuantity set pendingQuantity of both pending orders
+func (s *CreateOrderListOTOCOService) PendingQuantity(quantity string) *CreateOrderListOTOCOService {
+	s.pendingQuantity = quantity
+	return s
+}
+
+// PendingAbove set the pending order above the current price
+func (s *CreateOrderListOTOCOService) PendingAbove(above *OrderListLeg) *CreateOrderListOTOCOService {
+	s.pendingAbove = above
+	return s
+}
+
+// PendingBelow set the pending order below the current price
+func (s *CreateOrderListOTOCOService) PendingBelow(below *OrderListLeg) *CreateOrderListOTOCOService {
+	s.pendingBelow = below
+	return s
+}
+
+// ListClientOrderID set listClientOrderId
+func (s *CreateOrderListOTOCOService) ListClientOrderID(listClientOrderID string) *CreateOrderListOTOCOService {
+	s.listClientOrderID = &listClientOrderID
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateOrderListOTOCOService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderListOTOCOService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderListOTOCOService) SelfTradePreventionMode(mode STPModeType) *CreateOrderListOTOCOService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+// Do send request
+func (s *CreateOrderListOTOCOService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderListResponse, err error) {
+	m := params{
+		"symbol":          s.symbol,
+		"pendingSide":     s.pendingSide,
+		"pendingQuantity": s.pendingQuantity,
+	}
+	s.working.setParams(m, "working")
+	s.pendingAbove.setParams(m, "pendingAbove")
+	s.pendingBelow.setParams(m, "pendingBelow")
+	s.orderList.setParams(m)
+	return createOrderList(ctx, s.c, "/api/v3/orderList/otoco", m, opts...)
+}
@@ -0,0 +1,175 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type orderListServiceTestSuite struct {
+	baseTestSuite
+}
+
+func TestOrderListService(t *testing.T) {
+	suite.Run(t, new(orderListServiceTestSuite))
+}
+
+func (s *orderListServiceTestSuite) TestCreateOCO() {
+	data := []byte(`{
+		"orderListId": 1,
+		"contingencyType": "OCO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "lH1YDkuQKWiXVXHPSKYEIp",
+		"transactionTime": 1710485608839,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 10, "clientOrderId": "44nZvqpemY7sVYgPYbvPih"},
+			{"symbol": "LTCBTC", "orderId": 11, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK"}
+		],
+		"orderReports": [
+			{"symbol": "LTCBTC", "orderId": 10, "orderListId": 1, "clientOrderId": "44nZvqpemY7sVYgPYbvPih",
+			 "transactTime": 1710485608839, "price": "1.00000000", "origQty": "5.00000000", "executedQty": "0.00000000",
+			 "cummulativeQuoteQty": "0.00000000", "status": "NEW", "timeInForce": "GTC", "type": "STOP_LOSS_LIMIT",
+			 "side": "SELL", "stopPrice": "1.00000000"},
+			{"symbol": "LTCBTC", "orderId": 11, "orderListId": 1, "clientOrderId": "NuMp0nVYnciDiFmVqfpBqK",
+			 "transactTime": 1710485608839, "price": "3.00000000", "origQty": "5.00000000", "executedQty": "0.00000000",
+			 "cummulativeQuoteQty": "0.00000000", "status": "NEW", "timeInForce": "GTC", "type": "LIMIT_MAKER",
+			 "side": "SELL"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "LTCBTC",
+			"side":                    SideTypeSell,
+			"quantity":                "5",
+			"aboveType":               OrderTypeLimitMaker,
+			"abovePrice":              "3",
+			"belowType":               OrderTypeStopLossLimit,
+			"belowPrice":              "1",
+			"belowStopPrice":          "1",
+			"belowTimeInForce":        TimeInForceTypeGTC,
+			"listClientOrderId":       "lH1YDkuQKWiXVXHPSKYEIp",
+			"newOrderRespType":        NewOrderRespTypeFULL,
+			"selfTradePreventionMode": STPModeTypeExpireMaker,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOrderListOCOService().Symbol("LTCBTC").Side(SideTypeSell).Quantity("5").
+		Above(NewOrderListLeg(OrderTypeLimitMaker).Price("3")).
+		Below(NewOrderListLeg(OrderTypeStopLossLimit).Price("1").StopPrice("1").TimeInForce(TimeInForceTypeGTC)).
+		ListClientOrderID("lH1YDkuQKWiXVXHPSKYEIp").
+		NewOrderRespType(NewOrderRespTypeFULL).
+		SelfTradePreventionMode(STPModeTypeExpireMaker).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1), res.OrderListID)
+	r.Equal(ContingencyTypeOCO, res.ContingencyType)
+	r.Equal(ListStatusTypeExecStarted, res.ListStatusType)
+	r.Equal(ListOrderStatusTypeExecuting, res.ListOrderStatus)
+	r.Len(res.Orders, 2)
+	r.Len(res.OrderReports, 2)
+	r.Equal(OrderTypeStopLossLimit, res.OrderReports[0].Type)
+	r.Equal("1.00000000", res.OrderReports[0].StopPrice)
+}
+
+func (s *orderListServiceTestSuite) TestCreateOTO() {
+	data := []byte(`{
+		"orderListId": 626,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "KA4EBjGnzoVhNvv4VS4VOl",
+		"transactionTime": 1712289389158,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 13, "clientOrderId": "YiAUtM9yJjl1a2jXHSp9Ny"},
+			{"symbol": "LTCBTC", "orderId": 14, "clientOrderId": "9MxJSE1TYkmyx5lbGLve7R"}
+		],
+		"orderReports": [
+			{"symbol": "LTCBTC", "orderId": 13, "orderListId": 626, "clientOrderId": "YiAUtM9yJjl1a2jXHSp9Ny",
+			 "price": "1.00000000", "origQty": "1.00000000", "status": "NEW", "type": "LIMIT", "side": "SELL"},
+			{"symbol": "LTCBTC", "orderId": 14, "orderListId": 626, "clientOrderId": "9MxJSE1TYkmyx5lbGLve7R",
+			 "price": "0.00000000", "origQty": "5.00000000", "status": "PENDING_NEW", "type": "MARKET", "side": "BUY"}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":             "LTCBTC",
+			"workingType":        OrderTypeLimit,
+			"workingSide":        SideTypeSell,
+			"workingQuantity":    "1",
+			"workingPrice":       "1",
+			"workingTimeInForce": TimeInForceTypeGTC,
+			"pendingType":        OrderTypeMarket,
+			"pendingSide":        SideTypeBuy,
+			"pendingQuantity":    "5",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOrderListOTOService().Symbol("LTCBTC").
+		Working(NewOrderListLeg(OrderTypeLimit).Side(SideTypeSell).Quantity("1").Price("1").TimeInForce(TimeInForceTypeGTC)).
+		Pending(NewOrderListLeg(OrderTypeMarket).Side(SideTypeBuy).Quantity("5")).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(ContingencyTypeOTO, res.ContingencyType)
+	r.Equal(OrderStatusTypePendingNew, res.OrderReports[1].Status)
+}
+
+func (s *orderListServiceTestSuite) TestCreateOTOCO() {
+	data := []byte(`{
+		"orderListId": 629,
+		"contingencyType": "OTO",
+		"listStatusType": "EXEC_STARTED",
+		"listOrderStatus": "EXECUTING",
+		"listClientOrderId": "GaeJHjZPasPItFj4x7Mqm6",
+		"transactionTime": 1712291372842,
+		"symbol": "LTCBTC",
+		"orders": [
+			{"symbol": "LTCBTC", "orderId": 23, "clientOrderId": "OVQOpKwfmPCfaBTD0n7e7H"},
+			{"symbol": "LTCBTC", "orderId": 24, "clientOrderId": "YcCPKCDMQIjNvLtNswt82X"},
+			{"symbol": "LTCBTC", "orderId": 25, "clientOrderId": "ilpIoShcFZ1ZGgSASKxMPt"}
+		],
+		"orderReports": []
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                    "LTCBTC",
+			"workingType":               OrderTypeLimit,
+			"workingSide":               SideTypeBuy,
+			"workingQuantity":           "5",
+			"workingPrice":              "1.5",
+			"workingTimeInForce":        TimeInForceTypeGTC,
+			"pendingSide":               SideTypeSell,
+			"pendingQuantity":           "5",
+			"pendingAboveType":          OrderTypeLimitMaker,
+			"pendingAbovePrice":         "5",
+			"pendingBelowType":          OrderTypeStopLoss,
+			"pendingBelowStopPrice":     "0.5",
+			"pendingBelowStrategyId":    int64(1),
+			"pendingBelowClientOrderId": "stop",
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	res, err := s.client.NewCreateOrderListOTOCOService().Symbol("LTCBTC").
+		Working(NewOrderListLeg(OrderTypeLimit).Side(SideTypeBuy).Quantity("5").Price("1.5").TimeInForce(TimeInForceTypeGTC)).
+		PendingSide(SideTypeSell).PendingQuantity("5").
+		PendingAbove(NewOrderListLeg(OrderTypeLimitMaker).Price("5")).
+		PendingBelow(NewOrderListLeg(OrderTypeStopLoss).StopPrice("0.5").StrategyID(1).ClientOrderID("stop")).
+		Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(629), res.OrderListID)
+	r.Len(res.Orders, 3)
+}
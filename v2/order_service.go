@@ -4,23 +4,30 @@ import (
 	"context"
 	stdjson "encoding/json"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateOrderService create order
 type CreateOrderService struct {
-	c                *Client
-	symbol           string
-	side             SideType
-	orderType        OrderType
-	timeInForce      *TimeInForceType
-	newOrderRespType *NewOrderRespType
-	quantity         *string
-	quoteOrderQty    *string
-	price            *string
-	newClientOrderID *string
-	stopPrice        *string
-	trailingDelta    *string
-	icebergQuantity  *string
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	newOrderRespType        *NewOrderRespType
+	quantity                *string
+	quoteOrderQty           *string
+	price                   *string
+	newClientOrderID        *string
+	stopPrice               *string
+	trailingDelta           *string
+	icebergQuantity         *string
+	strategyID              *int64
+	strategyType            *int64
+	selfTradePreventionMode *STPModeType
 }
 
 // Symbol set symbol
@@ -53,18 +60,33 @@ func (s *CreateOrderService) Quantity(quantity string) *CreateOrderService {
 	return s
 }
 
+// QuantityDecimal set quantity
+func (s *CreateOrderService) QuantityDecimal(quantity common.Decimal) *CreateOrderService {
+	return s.Quantity(quantity.String())
+}
+
 // QuoteOrderQty set quoteOrderQty
 func (s *CreateOrderService) QuoteOrderQty(quoteOrderQty string) *CreateOrderService {
 	s.quoteOrderQty = &quoteOrderQty
 	return s
 }
 
+// QuoteOrderQtyDecimal set quoteOrderQty
+func (s *CreateOrderService) QuoteOrderQtyDecimal(quoteOrderQty common.Decimal) *CreateOrderService {
+	return s.QuoteOrderQty(quoteOrderQty.String())
+}
+
 // Price set price
 func (s *CreateOrderService) Price(price string) *CreateOrderService {
 	s.price = &price
 	return s
 }
 
+// PriceDecimal set price
+func (s *CreateOrderService) PriceDecimal(price common.Decimal) *CreateOrderService {
+	return s.Price(price.String())
+}
+
 // NewClientOrderID set newClientOrderID
 func (s *CreateOrderService) NewClientOrderID(newClientOrderID string) *CreateOrderService {
 	s.newClientOrderID = &newClientOrderID
@@ -77,6 +99,11 @@ func (s *CreateOrderService) StopPrice(stopPrice string) *CreateOrderService {
 	return s
 }
 
+// StopPriceDecimal set stopPrice
+func (s *CreateOrderService) StopPriceDecimal(stopPrice common.Decimal) *CreateOrderService {
+	return s.StopPrice(stopPrice.String())
+}
+
 // TrailingDelta set trailingDelta
 func (s *CreateOrderService) TrailingDelta(trailingDelta string) *CreateOrderService {
 	s.trailingDelta = &trailingDelta
@@ -89,18 +116,36 @@ func (s *CreateOrderService) IcebergQuantity(icebergQuantity string) *CreateOrde
 	return s
 }
 
+// IcebergQuantityDecimal set icebergQuantity
+func (s *CreateOrderService) IcebergQuantityDecimal(icebergQuantity common.Decimal) *CreateOrderService {
+	return s.IcebergQuantity(icebergQuantity.String())
+}
+
 // NewOrderRespType set icebergQuantity
 func (s *CreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateOrderService {
 	s.newOrderRespType = &newOrderRespType
 	return s
 }
 
-func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
-	r := &request{
-		method:   http.MethodPost,
-		endpoint: endpoint,
-		secType:  secTypeSigned,
-	}
+// StrategyID set strategyId, an arbitrary id to identify the orders of a strategy
+func (s *CreateOrderService) StrategyID(strategyID int64) *CreateOrderService {
+	s.strategyID = &strategyID
+	return s
+}
+
+// StrategyType set strategyType, it must be at least 1000000
+func (s *CreateOrderService) StrategyType(strategyType int64) *CreateOrderService {
+	s.strategyType = &strategyType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateOrderService) SelfTradePreventionMode(mode STPModeType) *CreateOrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+func (s *CreateOrderService) buildParams() params {
 	m := params{
 		"symbol": s.symbol,
 		"side":   s.side,
@@ -133,7 +178,25 @@ func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, o
 	if s.newOrderRespType != nil {
 		m["newOrderRespType"] = *s.newOrderRespType
 	}
-	r.setFormParams(m)
+	if s.strategyID != nil {
+		m["strategyId"] = *s.strategyID
+	}
+	if s.strategyType != nil {
+		m["strategyType"] = *s.strategyType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	return m
+}
+
+func (s *CreateOrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	r.setFormParams(s.buildParams())
 	data, err = s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return []byte{}, err
@@ -178,6 +241,11 @@ type CreateOrderResponse struct {
 	Type        OrderType       `json:"type"`
 	Side        SideType        `json:"side"`
 
+	StrategyID              int64       `json:"strategyId"`
+	StrategyType            int64       `json:"strategyType"`
+	WorkingTime             int64       `json:"workingTime"`
+	SelfTradePreventionMode STPModeType `json:"selfTradePreventionMode"`
+
 	// for order response is set to FULL
 	Fills                 []*Fill `json:"fills"`
 	MarginBuyBorrowAmount string  `json:"marginBuyBorrowAmount"` // for margin
@@ -191,6 +259,8 @@ type Fill struct {
 	Quantity        string `json:"qty"`
 	Commission      string `json:"commission"`
 	CommissionAsset string `json:"commissionAsset"`
+	MatchType       string `json:"matchType"` // for SOR orders
+	AllocID         int64  `json:"allocId"`
 }
 
 // CreateOCOService create order
@@ -485,6 +555,19 @@ func (s *GetOrderService) OrigClientOrderID(origClientOrderID string) *GetOrderS
 	return s
 }
 
+func (s *GetOrderService) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
+	}
+	if s.orderID != nil {
+		m["orderId"] = *s.orderID
+	}
+	if s.origClientOrderID != nil {
+		m["origClientOrderId"] = *s.origClientOrderID
+	}
+	return m
+}
+
 // Do send request
 func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
 	r := &request{
@@ -492,13 +575,7 @@ func (s *GetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *O
 		endpoint: "/api/v3/order",
 		secType:  secTypeSigned,
 	}
-	r.setParam("symbol", s.symbol)
-	if s.orderID != nil {
-		r.setParam("orderId", *s.orderID)
-	}
-	if s.origClientOrderID != nil {
-		r.setParam("origClientOrderId", *s.origClientOrderID)
-	}
+	r.setParams(s.buildParams())
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -532,6 +609,12 @@ type Order struct {
 	IsWorking                bool            `json:"isWorking"`
 	IsIsolated               bool            `json:"isIsolated"`
 	OrigQuoteOrderQuantity   string          `json:"origQuoteOrderQty"`
+	StrategyID               int64           `json:"strategyId"`
+	StrategyType             int64           `json:"strategyType"`
+	WorkingTime              int64           `json:"workingTime"`
+	SelfTradePreventionMode  STPModeType     `json:"selfTradePreventionMode"`
+	PreventedMatchID         int64           `json:"preventedMatchId"` // for orders expired by STP
+	PreventedQuantity        string          `json:"preventedQuantity"`
 }
 
 // ListOrdersService all account orders; active, canceled, or filled
@@ -606,6 +689,46 @@ func (s *ListOrdersService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterate walk the orders from orderID, or by windows of 24 hours from startTime
+// to endTime (default now), or from the first order
+func (s *ListOrdersService) Iterate(opts ...RequestOption) *common.Iterator[*Order] {
+	limit := 1000
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	if s.startTime != nil && s.orderID == nil {
+		end := time.Now().UnixMilli()
+		if s.endTime != nil {
+			end = *s.endTime
+		}
+		return common.NewTimeIterator(*s.startTime, end, 24*time.Hour, limit,
+			func(ctx context.Context, start, end int64) ([]*Order, error) {
+				page := *s
+				return page.StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+			},
+			func(o *Order) int64 { return o.Time },
+			func(o *Order) string { return strconv.FormatInt(o.OrderID, 10) })
+	}
+	var fromID int64
+	if s.orderID != nil {
+		fromID = *s.orderID
+	}
+	return common.NewIDIterator(fromID, limit, func(ctx context.Context, fromID int64) ([]*Order, error) {
+		page := *s
+		page.startTime, page.endTime = nil, nil
+		orders, err := page.OrderID(fromID).Limit(limit).Do(ctx, opts...)
+		if err != nil || s.endTime == nil {
+			return orders, err
+		}
+		for i, o := range orders {
+			if o.Time > *s.endTime {
+				return orders[:i], nil
+			}
+		}
+		return orders, nil
+	}, func(o *Order) int64 { return o.OrderID })
+}
+
 // CancelOrderService cancel an order
 type CancelOrderService struct {
 	c                 *Client
@@ -639,23 +762,30 @@ func (s *CancelOrderService) NewClientOrderID(newClientOrderID string) *CancelOr
 	return s
 }
 
-// Do send request
-func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
-	r := &request{
-		method:   http.MethodDelete,
-		endpoint: "/api/v3/order",
-		secType:  secTypeSigned,
+func (s *CancelOrderService) buildParams() params {
+	m := params{
+		"symbol": s.symbol,
 	}
-	r.setFormParam("symbol", s.symbol)
 	if s.orderID != nil {
-		r.setFormParam("orderId", *s.orderID)
+		m["orderId"] = *s.orderID
 	}
 	if s.origClientOrderID != nil {
-		r.setFormParam("origClientOrderId", *s.origClientOrderID)
+		m["origClientOrderId"] = *s.origClientOrderID
 	}
 	if s.newClientOrderID != nil {
-		r.setFormParam("newClientOrderId", *s.newClientOrderID)
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	return m
+}
+
+// Do send request
+func (s *CancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	r := &request{
+		method:   http.MethodDelete,
+		endpoint: "/api/v3/order",
+		secType:  secTypeSigned,
 	}
+	r.setFormParams(s.buildParams())
 	data, err := s.c.callAPI(ctx, r, opts...)
 	if err != nil {
 		return nil, err
@@ -84,6 +84,68 @@ func (s *orderServiceTestSuite) TestCreateOrder() {
 	s.r().NoError(err)
 }
 
+func (s *orderServiceTestSuite) TestCreateOrderSTP() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 2,
+		"orderListId": -1,
+		"clientOrderId": "myOrder2",
+		"transactTime": 1499827319559,
+		"price": "0.0001",
+		"origQty": "12.00",
+		"executedQty": "0.00",
+		"cummulativeQuoteQty": "0.00",
+		"status": "EXPIRED_IN_MATCH",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "SELL",
+		"strategyId": 37463720,
+		"strategyType": 1000000,
+		"workingTime": 1499827319559,
+		"selfTradePreventionMode": "EXPIRE_TAKER"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setFormParams(params{
+			"symbol":                  "LTCBTC",
+			"side":                    SideTypeSell,
+			"type":                    OrderTypeLimit,
+			"timeInForce":             TimeInForceTypeGTC,
+			"quantity":                "12.00",
+			"price":                   "0.0001",
+			"strategyId":              int64(37463720),
+			"strategyType":            int64(1000000),
+			"selfTradePreventionMode": STPModeTypeExpireTaker,
+		})
+		s.assertRequestEqual(e, r)
+	})
+	res, err := s.client.NewCreateOrderService().Symbol("LTCBTC").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Quantity("12.00").Price("0.0001").
+		StrategyID(37463720).StrategyType(1000000).SelfTradePreventionMode(STPModeTypeExpireTaker).
+		Do(newContext())
+	s.r().NoError(err)
+	e := &CreateOrderResponse{
+		Symbol:                   "LTCBTC",
+		OrderID:                  2,
+		ClientOrderID:            "myOrder2",
+		TransactTime:             1499827319559,
+		Price:                    "0.0001",
+		OrigQuantity:             "12.00",
+		ExecutedQuantity:         "0.00",
+		CummulativeQuoteQuantity: "0.00",
+		Status:                   OrderStatusExpiredInMatch,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+		StrategyID:               37463720,
+		StrategyType:             1000000,
+		WorkingTime:              1499827319559,
+		SelfTradePreventionMode:  STPModeTypeExpireTaker,
+	}
+	s.assertCreateOrderResponseEqual(e, res)
+}
+
 func (s *orderServiceTestSuite) TestCreateOrderFull() {
 	data := []byte(`{
 		"symbol": "LTCBTC",
@@ -183,6 +245,10 @@ func (s *baseOrderTestSuite) assertCreateOrderResponseEqual(e, a *CreateOrderRes
 	r.Equal(e.TimeInForce, a.TimeInForce, "TimeInForce")
 	r.Equal(e.Type, a.Type, "Type")
 	r.Equal(e.Side, a.Side, "Side")
+	r.Equal(e.StrategyID, a.StrategyID, "StrategyID")
+	r.Equal(e.StrategyType, a.StrategyType, "StrategyType")
+	r.Equal(e.WorkingTime, a.WorkingTime, "WorkingTime")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
 
 	r.Len(a.Fills, len(e.Fills))
 	for idx, fill := range e.Fills {
@@ -542,6 +608,12 @@ func (s *baseOrderTestSuite) assertOrderEqual(e, a *Order) {
 	r.Equal(e.UpdateTime, a.UpdateTime, "UpdateTime")
 	r.Equal(e.IsWorking, a.IsWorking, "IsWorking")
 	r.Equal(e.OrigQuoteOrderQuantity, a.OrigQuoteOrderQuantity, "OrigQuoteOrderQuantity")
+	r.Equal(e.StrategyID, a.StrategyID, "StrategyID")
+	r.Equal(e.StrategyType, a.StrategyType, "StrategyType")
+	r.Equal(e.WorkingTime, a.WorkingTime, "WorkingTime")
+	r.Equal(e.SelfTradePreventionMode, a.SelfTradePreventionMode, "SelfTradePreventionMode")
+	r.Equal(e.PreventedMatchID, a.PreventedMatchID, "PreventedMatchID")
+	r.Equal(e.PreventedQuantity, a.PreventedQuantity, "PreventedQuantity")
 }
 
 func (s *orderServiceTestSuite) TestGetOrder() {
@@ -602,6 +674,63 @@ func (s *orderServiceTestSuite) TestGetOrder() {
 	s.assertOrderEqual(e, order)
 }
 
+func (s *orderServiceTestSuite) TestGetOrderExpiredInMatch() {
+	data := []byte(`{
+		"symbol": "LTCBTC",
+		"orderId": 1,
+		"orderListId": -1,
+		"clientOrderId": "myOrder1",
+		"price": "0.1",
+		"origQty": "1.0",
+		"executedQty": "0.0",
+		"cummulativeQuoteQty": "0.0",
+		"status": "EXPIRED_IN_MATCH",
+		"timeInForce": "GTC",
+		"type": "LIMIT",
+		"side": "BUY",
+		"stopPrice": "0.0",
+		"icebergQty": "0.0",
+		"time": 1499827319559,
+		"updateTime": 1499827319559,
+		"isWorking": true,
+		"workingTime": 1499827319559,
+		"origQuoteOrderQty": "0.000000",
+		"selfTradePreventionMode": "EXPIRE_MAKER",
+		"preventedMatchId": 0,
+		"preventedQuantity": "1.0"
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+
+	order, err := s.client.NewGetOrderService().Symbol("LTCBTC").OrderID(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	e := &Order{
+		Symbol:                   "LTCBTC",
+		OrderID:                  1,
+		OrderListId:              -1,
+		ClientOrderID:            "myOrder1",
+		Price:                    "0.1",
+		OrigQuantity:             "1.0",
+		ExecutedQuantity:         "0.0",
+		CummulativeQuoteQuantity: "0.0",
+		Status:                   OrderStatusExpiredInMatch,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeBuy,
+		StopPrice:                "0.0",
+		IcebergQuantity:          "0.0",
+		Time:                     1499827319559,
+		UpdateTime:               1499827319559,
+		IsWorking:                true,
+		WorkingTime:              1499827319559,
+		OrigQuoteOrderQuantity:   "0.000000",
+		SelfTradePreventionMode:  STPModeTypeExpireMaker,
+		PreventedQuantity:        "1.0",
+	}
+	s.assertOrderEqual(e, order)
+}
+
 func (s *orderServiceTestSuite) TestListOrders() {
 	data := []byte(`[
         {
@@ -0,0 +1,320 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// OrderValidator check orders against the filters of their symbol before they
+// are sent, so that they are not rejected by the server with -1013. The exchange
+// info is loaded on first use and cached.
+type OrderValidator struct {
+	// TTL of the cached exchange info, 0 to keep it until Reload
+	TTL time.Duration
+	// CheckPercentPrice fetch the average price of the symbol to check the
+	// PERCENT_PRICE_BY_SIDE filter and the notional of market orders
+	CheckPercentPrice bool
+	// CheckOpenOrders fetch the open orders of the symbol to check the
+	// MAX_NUM_ORDERS and MAX_NUM_ALGO_ORDERS filters
+	CheckOpenOrders bool
+	// Registry provides the symbols instead of the cache of the validator if set
+	Registry *SymbolRegistry
+
+	c        *Client
+	mu       sync.Mutex
+	symbols  map[string]*Symbol
+	loadedAt time.Time
+}
+
+// Reload load the exchange info
+func (v *OrderValidator) Reload(ctx context.Context) error {
+	info, err := v.c.NewExchangeInfoService().Do(ctx)
+	if err != nil {
+		return err
+	}
+	v.SetExchangeInfo(info)
+	return nil
+}
+
+// SetExchangeInfo set the exchange info, e.g. one already loaded by the caller
+func (v *OrderValidator) SetExchangeInfo(info *ExchangeInfo) {
+	symbols := make(map[string]*Symbol, len(info.Symbols))
+	for i := range info.Symbols {
+		symbols[info.Symbols[i].Symbol] = &info.Symbols[i]
+	}
+	v.mu.Lock()
+	v.symbols = symbols
+	v.loadedAt = time.Now()
+	v.mu.Unlock()
+}
+
+// Symbol return the cached info of symbol, loading the exchange info if needed
+func (v *OrderValidator) Symbol(ctx context.Context, symbol string) (*Symbol, error) {
+	if v.Registry != nil {
+		if _, loaded := v.Registry.Loaded(); !loaded {
+			if err := v.Registry.Refresh(ctx); err != nil {
+				return nil, err
+			}
+		}
+		s, ok := v.Registry.Symbol(symbol)
+		if !ok {
+			return nil, fmt.Errorf("unknown symbol %q", symbol)
+		}
+		return s, nil
+	}
+	v.mu.Lock()
+	expired := v.symbols == nil || (v.TTL > 0 && time.Since(v.loadedAt) > v.TTL)
+	v.mu.Unlock()
+	if expired {
+		if err := v.Reload(ctx); err != nil {
+			return nil, err
+		}
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	s, ok := v.symbols[symbol]
+	if !ok {
+		return nil, fmt.Errorf("unknown symbol %q", symbol)
+	}
+	return s, nil
+}
+
+// Validate check the order of s, it returns a *common.FilterError naming the
+// first filter the order breaks
+func (v *OrderValidator) Validate(ctx context.Context, s *CreateOrderService) error {
+	symbol, err := v.Symbol(ctx, s.symbol)
+	if err != nil {
+		return err
+	}
+	var avgPrice common.Decimal
+	if v.CheckPercentPrice {
+		res, err := v.c.NewAveragePriceService().Symbol(s.symbol).Do(ctx)
+		if err != nil {
+			return err
+		}
+		avgPrice = common.DecimalOrZero(res.Price)
+	}
+	openOrders, openAlgoOrders := -1, -1
+	if v.CheckOpenOrders {
+		orders, err := v.c.NewListOpenOrdersService().Symbol(s.symbol).Do(ctx)
+		if err != nil {
+			return err
+		}
+		openOrders, openAlgoOrders = len(orders), 0
+		for _, o := range orders {
+			if isAlgoOrderType(o.Type) {
+				openAlgoOrders++
+			}
+		}
+	}
+	return validateOrder(symbol, s, avgPrice, openOrders, openAlgoOrders)
+}
+
+// Normalize round down the price, stop price and quantities of s to the tick
+// size and step size of the symbol, then validate it
+func (v *OrderValidator) Normalize(ctx context.Context, s *CreateOrderService) error {
+	symbol, err := v.Symbol(ctx, s.symbol)
+	if err != nil {
+		return err
+	}
+	if f := symbol.PriceFilter(); f != nil {
+		min, tick := common.DecimalOrZero(f.MinPrice), common.DecimalOrZero(f.TickSize)
+		if s.price != nil {
+			s.PriceDecimal(roundToStep(common.DecimalOrZero(*s.price), min, tick))
+		}
+		if s.stopPrice != nil {
+			s.StopPriceDecimal(roundToStep(common.DecimalOrZero(*s.stopPrice), min, tick))
+		}
+	}
+	if f := symbol.LotSizeFilter(); f != nil {
+		min, step := common.DecimalOrZero(f.MinQuantity), common.DecimalOrZero(f.StepSize)
+		if s.quantity != nil {
+			s.QuantityDecimal(roundToStep(common.DecimalOrZero(*s.quantity), min, step))
+		}
+		if s.icebergQuantity != nil {
+			s.IcebergQuantityDecimal(roundToStep(common.DecimalOrZero(*s.icebergQuantity), min, step))
+		}
+	}
+	if f := symbol.MarketLotSizeFilter(); f != nil && isMarketOrderType(s.orderType) && s.quantity != nil {
+		min, step := common.DecimalOrZero(f.MinQuantity), common.DecimalOrZero(f.StepSize)
+		s.QuantityDecimal(roundToStep(common.DecimalOrZero(*s.quantity), min, step))
+	}
+	return v.Validate(ctx, s)
+}
+
+func isMarketOrderType(t OrderType) bool {
+	return t == OrderTypeMarket || t == OrderTypeStopLoss || t == OrderTypeTakeProfit
+}
+
+func isAlgoOrderType(t OrderType) bool {
+	switch t {
+	case OrderTypeStopLoss, OrderTypeStopLossLimit, OrderTypeTakeProfit, OrderTypeTakeProfitLimit:
+		return true
+	}
+	return false
+}
+
+// roundToStep round v down to min plus a multiple of step
+func roundToStep(v, min, step common.Decimal) common.Decimal {
+	if step.Sign() <= 0 || v.Cmp(min) < 0 {
+		return v
+	}
+	return min.Add(v.Sub(min).TruncateToStep(step)).Trim()
+}
+
+func validateOrder(symbol *Symbol, s *CreateOrderService, avgPrice common.Decimal, openOrders, openAlgoOrders int) error {
+	fail := func(filter SymbolFilterType, field string, value interface{}, reason string, args ...interface{}) error {
+		return &common.FilterError{
+			Symbol: symbol.Symbol,
+			Filter: string(filter),
+			Field:  field,
+			Value:  fmt.Sprint(value),
+			Reason: fmt.Sprintf(reason, args...),
+		}
+	}
+	parse := func(field string, v *string) (common.Decimal, bool, error) {
+		if v == nil {
+			return common.Decimal{}, false, nil
+		}
+		d, err := common.ParseDecimal(*v)
+		if err != nil {
+			return d, false, fmt.Errorf("invalid %s: %w", field, err)
+		}
+		return d, true, nil
+	}
+	market := isMarketOrderType(s.orderType)
+
+	price, hasPrice, err := parse("price", s.price)
+	if err != nil {
+		return err
+	}
+	stopPrice, hasStopPrice, err := parse("stopPrice", s.stopPrice)
+	if err != nil {
+		return err
+	}
+	quantity, hasQuantity, err := parse("quantity", s.quantity)
+	if err != nil {
+		return err
+	}
+	quoteOrderQty, hasQuoteOrderQty, err := parse("quoteOrderQty", s.quoteOrderQty)
+	if err != nil {
+		return err
+	}
+	icebergQty, hasIcebergQty, err := parse("icebergQty", s.icebergQuantity)
+	if err != nil {
+		return err
+	}
+
+	if f := symbol.PriceFilter(); f != nil {
+		min, max, tick := common.DecimalOrZero(f.MinPrice), common.DecimalOrZero(f.MaxPrice), common.DecimalOrZero(f.TickSize)
+		check := func(field string, p common.Decimal) error {
+			switch {
+			case min.Sign() > 0 && p.Cmp(min) < 0:
+				return fail(SymbolFilterTypePriceFilter, field, p, "is less than minPrice %s", f.MinPrice)
+			case max.Sign() > 0 && p.Cmp(max) > 0:
+				return fail(SymbolFilterTypePriceFilter, field, p, "is greater than maxPrice %s", f.MaxPrice)
+			case tick.Sign() > 0 && !p.Sub(min).IsMultipleOf(tick):
+				return fail(SymbolFilterTypePriceFilter, field, p, "is not a multiple of tickSize %s", f.TickSize)
+			}
+			return nil
+		}
+		if hasPrice {
+			if err := check("price", price); err != nil {
+				return err
+			}
+		}
+		if hasStopPrice {
+			if err := check("stopPrice", stopPrice); err != nil {
+				return err
+			}
+		}
+	}
+
+	if f := symbol.PercentPriceBySideFilter(); f != nil && hasPrice && avgPrice.Sign() > 0 {
+		up, down := f.BidMultiplierUp, f.BidMultiplierDown
+		if s.side == SideTypeSell {
+			up, down = f.AskMultiplierUp, f.AskMultiplierDown
+		}
+		if high := avgPrice.Mul(common.DecimalOrZero(up)); high.Sign() > 0 && price.Cmp(high) > 0 {
+			return fail(SymbolFilterTypePercentPriceBySide, "price", price, "is greater than %s times the average price %s", up, avgPrice)
+		}
+		if low := avgPrice.Mul(common.DecimalOrZero(down)); price.Cmp(low) < 0 {
+			return fail(SymbolFilterTypePercentPriceBySide, "price", price, "is less than %s times the average price %s", down, avgPrice)
+		}
+	}
+
+	checkLot := func(filter SymbolFilterType, field string, q common.Decimal, minQty, maxQty, stepSize string) error {
+		min, max, step := common.DecimalOrZero(minQty), common.DecimalOrZero(maxQty), common.DecimalOrZero(stepSize)
+		switch {
+		case q.Cmp(min) < 0:
+			return fail(filter, field, q, "is less than minQty %s", minQty)
+		case max.Sign() > 0 && q.Cmp(max) > 0:
+			return fail(filter, field, q, "is greater than maxQty %s", maxQty)
+		case step.Sign() > 0 && !q.Sub(min).IsMultipleOf(step):
+			return fail(filter, field, q, "is not a multiple of stepSize %s", stepSize)
+		}
+		return nil
+	}
+	if f := symbol.LotSizeFilter(); f != nil {
+		if hasQuantity {
+			if err := checkLot(SymbolFilterTypeLotSize, "quantity", quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+				return err
+			}
+		}
+		if hasIcebergQty {
+			if err := checkLot(SymbolFilterTypeLotSize, "icebergQty", icebergQty, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+				return err
+			}
+		}
+	}
+	if f := symbol.MarketLotSizeFilter(); f != nil && market && hasQuantity {
+		if err := checkLot(SymbolFilterTypeMarketLotSize, "quantity", quantity, f.MinQuantity, f.MaxQuantity, f.StepSize); err != nil {
+			return err
+		}
+	}
+
+	if f := symbol.IcebergPartsFilter(); f != nil && hasIcebergQty && hasQuantity && f.Limit > 0 && icebergQty.Sign() > 0 {
+		parts := quantity.Div(icebergQty, 0)
+		if !quantity.IsMultipleOf(icebergQty) {
+			parts = parts.Add(common.NewDecimal(1, 0))
+		}
+		if parts.Cmp(common.NewDecimal(int64(f.Limit), 0)) > 0 {
+			return fail(SymbolFilterTypeIcebergParts, "icebergQty", icebergQty, "splits quantity %s in more than %d parts", quantity, f.Limit)
+		}
+	}
+
+	if f := symbol.NotionalFilter(); f != nil {
+		var notional common.Decimal
+		hasNotional := true
+		switch {
+		case hasQuoteOrderQty:
+			notional = quoteOrderQty
+		case !market && hasPrice && hasQuantity:
+			notional = price.Mul(quantity)
+		case market && hasQuantity && avgPrice.Sign() > 0:
+			notional = avgPrice.Mul(quantity)
+		default:
+			hasNotional = false
+		}
+		min, max := common.DecimalOrZero(f.MinNotional), common.DecimalOrZero(f.MaxNotional)
+		if hasNotional && (!market || f.ApplyMinToMarket) && notional.Cmp(min) < 0 {
+			return fail(SymbolFilterTypeNotional, "notional", notional, "is less than minNotional %s", f.MinNotional)
+		}
+		if hasNotional && (!market || f.ApplyMaxToMarket) && max.Sign() > 0 && notional.Cmp(max) > 0 {
+			return fail(SymbolFilterTypeNotional, "notional", notional, "is greater than maxNotional %s", f.MaxNotional)
+		}
+	}
+
+	if f := symbol.MaxNumOrdersFilter(); f != nil && openOrders >= 0 && f.MaxNumOrders > 0 && openOrders >= f.MaxNumOrders {
+		return fail(SymbolFilterTypeMaxNumOrders, "openOrders", openOrders, "reached maxNumOrders %d", f.MaxNumOrders)
+	}
+	if f := symbol.MaxNumAlgoOrdersFilter(); f != nil && isAlgoOrderType(s.orderType) && openAlgoOrders >= 0 &&
+		f.MaxNumAlgoOrders > 0 && openAlgoOrders >= f.MaxNumAlgoOrders {
+		return fail(SymbolFilterTypeMaxNumAlgoOrders, "openAlgoOrders", openAlgoOrders, "reached maxNumAlgoOrders %d", f.MaxNumAlgoOrders)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package binance
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type orderValidatorTestSuite struct {
+	baseTestSuite
+	validator *OrderValidator
+}
+
+func TestOrderValidator(t *testing.T) {
+	suite.Run(t, new(orderValidatorTestSuite))
+}
+
+func (s *orderValidatorTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	info := new(ExchangeInfo)
+	err := json.Unmarshal([]byte(`{
+		"symbols": [
+			{
+				"symbol": "BTCUSDT",
+				"status": "TRADING",
+				"filters": [
+					{"filterType": "PRICE_FILTER", "minPrice": "0.01000000", "maxPrice": "1000000.00000000", "tickSize": "0.01000000"},
+					{"filterType": "LOT_SIZE", "minQty": "0.00001000", "maxQty": "9000.00000000", "stepSize": "0.00001000"},
+					{"filterType": "ICEBERG_PARTS", "limit": 10},
+					{"filterType": "MARKET_LOT_SIZE", "minQty": "0.00000000", "maxQty": "100.00000000", "stepSize": "0.00000000"},
+					{"filterType": "PERCENT_PRICE_BY_SIDE", "bidMultiplierUp": "5", "bidMultiplierDown": "0.2", "askMultiplierUp": "5", "askMultiplierDown": "0.2", "avgPriceMins": 5},
+					{"filterType": "NOTIONAL", "minNotional": "5.00000000", "applyMinToMarket": true, "maxNotional": "9000000.00000000", "applyMaxToMarket": false, "avgPriceMins": 5},
+					{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 2},
+					{"filterType": "MAX_NUM_ALGO_ORDERS", "maxNumAlgoOrders": 5}
+				]
+			}
+		]
+	}`), info)
+	s.r().NoError(err)
+	s.validator = s.client.NewOrderValidator()
+	s.validator.SetExchangeInfo(info)
+}
+
+func (s *orderValidatorTestSuite) newOrder() *CreateOrderService {
+	return s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeLimit).
+		TimeInForce(TimeInForceTypeGTC).Quantity("0.001").Price("30000.01")
+}
+
+func (s *orderValidatorTestSuite) assertFilterError(err error, filter SymbolFilterType, field string) {
+	s.r().Error(err)
+	s.r().True(errors.Is(err, common.ErrInvalidMessage))
+	filterErr, ok := common.AsFilterError(err)
+	s.r().True(ok, err.Error())
+	s.r().Equal("BTCUSDT", filterErr.Symbol)
+	s.r().Equal(string(filter), filterErr.Filter)
+	s.r().Equal(field, filterErr.Field)
+}
+
+func (s *orderValidatorTestSuite) TestValidate() {
+	ctx := newContext()
+	s.r().NoError(s.validator.Validate(ctx, s.newOrder()))
+
+	err := s.validator.Validate(ctx, s.newOrder().Price("30000.015"))
+	s.assertFilterError(err, SymbolFilterTypePriceFilter, "price")
+	s.r().Equal("30000.015 is not a multiple of tickSize 0.01000000", err.(*common.FilterError).Value+" "+err.(*common.FilterError).Reason)
+
+	err = s.validator.Validate(ctx, s.newOrder().Price("0.001"))
+	s.assertFilterError(err, SymbolFilterTypePriceFilter, "price")
+
+	err = s.validator.Validate(ctx, s.newOrder().Type(OrderTypeStopLossLimit).StopPrice("2000000"))
+	s.assertFilterError(err, SymbolFilterTypePriceFilter, "stopPrice")
+
+	err = s.validator.Validate(ctx, s.newOrder().Quantity("0.000015"))
+	s.assertFilterError(err, SymbolFilterTypeLotSize, "quantity")
+
+	err = s.validator.Validate(ctx, s.newOrder().Quantity("0.000001"))
+	s.assertFilterError(err, SymbolFilterTypeLotSize, "quantity")
+
+	err = s.validator.Validate(ctx, s.newOrder().Quantity("10000"))
+	s.assertFilterError(err, SymbolFilterTypeLotSize, "quantity")
+
+	market := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket)
+	err = s.validator.Validate(ctx, market.Quantity("101"))
+	s.assertFilterError(err, SymbolFilterTypeMarketLotSize, "quantity")
+
+	err = s.validator.Validate(ctx, s.newOrder().Quantity("0.0001"))
+	s.assertFilterError(err, SymbolFilterTypeNotional, "notional")
+
+	err = s.validator.Validate(ctx, s.newOrder().Quantity("0.01").IcebergQuantity("0.0009"))
+	s.assertFilterError(err, SymbolFilterTypeIcebergParts, "icebergQty")
+	s.r().NoError(s.validator.Validate(ctx, s.newOrder().Quantity("0.01").IcebergQuantity("0.001")))
+
+	err = s.validator.Validate(ctx, s.newOrder().Price("abc"))
+	s.r().Error(err)
+	_, ok := common.AsFilterError(err)
+	s.r().False(ok)
+
+	_, err = s.validator.Symbol(ctx, "UNKNOWN")
+	s.r().Error(err)
+}
+
+func (s *orderValidatorTestSuite) TestValidateMarketQuoteOrderQty() {
+	order := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).Type(OrderTypeMarket)
+	s.r().NoError(s.validator.Validate(newContext(), order.QuoteOrderQty("10")))
+	err := s.validator.Validate(newContext(), order.QuoteOrderQty("1"))
+	s.assertFilterError(err, SymbolFilterTypeNotional, "notional")
+}
+
+func (s *orderValidatorTestSuite) TestValidatePercentPrice() {
+	s.mockDo([]byte(`{"mins": 5, "price": "1000.00"}`), nil)
+	defer s.assertDo()
+	s.validator.CheckPercentPrice = true
+	err := s.validator.Validate(newContext(), s.newOrder().Price("5000.01"))
+	s.assertFilterError(err, SymbolFilterTypePercentPriceBySide, "price")
+	s.r().Equal("is greater than 5 times the average price 1000.00", err.(*common.FilterError).Reason)
+}
+
+func (s *orderValidatorTestSuite) TestValidateOpenOrders() {
+	s.mockDo([]byte(`[{"symbol": "BTCUSDT", "type": "LIMIT"}, {"symbol": "BTCUSDT", "type": "STOP_LOSS_LIMIT"}]`), nil)
+	defer s.assertDo()
+	s.validator.CheckOpenOrders = true
+	err := s.validator.Validate(newContext(), s.newOrder())
+	s.assertFilterError(err, SymbolFilterTypeMaxNumOrders, "openOrders")
+}
+
+func (s *orderValidatorTestSuite) TestNormalize() {
+	order := s.newOrder().Price("30000.0199").Quantity("0.0012345").IcebergQuantity("0.00033333")
+	s.r().NoError(s.validator.Normalize(newContext(), order))
+	s.r().Equal("30000.01", *order.price)
+	s.r().Equal("0.00123", *order.quantity)
+	s.r().Equal("0.00033", *order.icebergQuantity)
+
+	order = s.newOrder().Quantity("0.0000012")
+	err := s.validator.Normalize(newContext(), order)
+	s.assertFilterError(err, SymbolFilterTypeLotSize, "quantity")
+}
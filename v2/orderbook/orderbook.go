@@ -0,0 +1,357 @@
+// Package orderbook maintains local order books by syncing depth snapshots
+// with diff depth streams, following the steps described in
+// https://binance-docs.github.io/apidocs/spot/en/#how-to-manage-a-local-order-book-correctly
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	defaultLimit    = 1000
+	eventBufferSize = 1024
+)
+
+var (
+	// ErrSequenceGap is reported when a diff depth event does not follow the previous one
+	ErrSequenceGap = errors.New("orderbook: diff depth event out of sequence")
+	// ErrStreamClosed is reported when the diff depth stream stopped on its own
+	ErrStreamClosed = errors.New("orderbook: diff depth stream closed")
+	// ErrBufferFull is reported when events arrive faster than they can be applied
+	ErrBufferFull = errors.New("orderbook: diff depth event buffer full")
+)
+
+type level struct {
+	price float64
+	level common.PriceLevel
+}
+
+// side keeps the levels of one side sorted from the best price
+type side struct {
+	desc   bool
+	levels []level
+}
+
+func (s *side) set(pl common.PriceLevel) error {
+	price, quantity, err := pl.Parse()
+	if err != nil {
+		return err
+	}
+	i := sort.Search(len(s.levels), func(i int) bool {
+		if s.desc {
+			return s.levels[i].price <= price
+		}
+		return s.levels[i].price >= price
+	})
+	found := i < len(s.levels) && s.levels[i].price == price
+	switch {
+	case quantity == 0:
+		if found {
+			s.levels = append(s.levels[:i], s.levels[i+1:]...)
+		}
+	case found:
+		s.levels[i].level = pl
+	default:
+		s.levels = append(s.levels, level{})
+		copy(s.levels[i+1:], s.levels[i:])
+		s.levels[i] = level{price: price, level: pl}
+	}
+	return nil
+}
+
+func (s *side) top(n int) []common.PriceLevel {
+	if n <= 0 || n > len(s.levels) {
+		n = len(s.levels)
+	}
+	res := make([]common.PriceLevel, n)
+	for i := range res {
+		res[i] = s.levels[i].level
+	}
+	return res
+}
+
+// OrderBook is a local order book of one symbol kept in sync with a Source
+type OrderBook struct {
+	source     Source
+	symbol     string
+	limit      int
+	backoff    common.BackoffPolicy
+	onUpdate   func(b *OrderBook)
+	errHandler func(err error)
+
+	mu           sync.RWMutex
+	bids         side
+	asks         side
+	lastUpdateID int64
+	synced       bool
+	cancel       context.CancelFunc
+	doneC        chan struct{}
+}
+
+// NewOrderBook init an order book of symbol, call Start to sync it
+func NewOrderBook(source Source, symbol string) *OrderBook {
+	return &OrderBook{
+		source:  source,
+		symbol:  symbol,
+		limit:   defaultLimit,
+		backoff: common.DefaultBackoff,
+		bids:    side{desc: true},
+		doneC:   make(chan struct{}),
+	}
+}
+
+// Limit set the depth of the snapshots, default 1000
+func (b *OrderBook) Limit(limit int) *OrderBook {
+	b.limit = limit
+	return b
+}
+
+// Backoff set the delay between resync attempts, default common.DefaultBackoff
+func (b *OrderBook) Backoff(backoff common.BackoffPolicy) *OrderBook {
+	b.backoff = backoff
+	return b
+}
+
+// OnUpdate set the handler called after each change of the book,
+// the book is not locked so the handler can read it
+func (b *OrderBook) OnUpdate(handler func(b *OrderBook)) *OrderBook {
+	b.onUpdate = handler
+	return b
+}
+
+// ErrHandler set the handler called with the reason of each resync
+func (b *OrderBook) ErrHandler(handler func(err error)) *OrderBook {
+	b.errHandler = handler
+	return b
+}
+
+// Start sync the book in the background until Stop is called or ctx is done
+func (b *OrderBook) Start(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.cancel != nil {
+		return errors.New("orderbook: already started")
+	}
+	ctx, b.cancel = context.WithCancel(ctx)
+	go b.run(ctx)
+	return nil
+}
+
+// Stop stop syncing the book, Done is closed once it is stopped
+func (b *OrderBook) Stop() {
+	b.mu.RLock()
+	cancel := b.cancel
+	b.mu.RUnlock()
+	if cancel != nil {
+		cancel()
+	}
+}
+
+// Done return a channel closed once the book is stopped
+func (b *OrderBook) Done() <-chan struct{} {
+	return b.doneC
+}
+
+// Symbol return the symbol of the book
+func (b *OrderBook) Symbol() string {
+	return b.symbol
+}
+
+// Synced tells if the book is in sync with the stream, the levels are stale otherwise
+func (b *OrderBook) Synced() bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.synced
+}
+
+// LastUpdateID return the update id of the last applied snapshot or event
+func (b *OrderBook) LastUpdateID() int64 {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.lastUpdateID
+}
+
+// BestBid return the highest bid, false if there is none
+func (b *OrderBook) BestBid() (common.PriceLevel, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.bids.levels) == 0 {
+		return common.PriceLevel{}, false
+	}
+	return b.bids.levels[0].level, true
+}
+
+// BestAsk return the lowest ask, false if there is none
+func (b *OrderBook) BestAsk() (common.PriceLevel, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if len(b.asks.levels) == 0 {
+		return common.PriceLevel{}, false
+	}
+	return b.asks.levels[0].level, true
+}
+
+// Bids return the n highest bids, all of them if n <= 0
+func (b *OrderBook) Bids(n int) []common.PriceLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.bids.top(n)
+}
+
+// Asks return the n lowest asks, all of them if n <= 0
+func (b *OrderBook) Asks(n int) []common.PriceLevel {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.asks.top(n)
+}
+
+func (b *OrderBook) run(ctx context.Context) {
+	defer close(b.doneC)
+	attempt := 0
+	for {
+		applied, err := b.sync(ctx)
+		b.mu.Lock()
+		b.synced = false
+		b.mu.Unlock()
+		if ctx.Err() != nil {
+			return
+		}
+		if applied {
+			attempt = 0
+		}
+		attempt++
+		if b.errHandler != nil {
+			b.errHandler(err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.backoff.Backoff(attempt)):
+		}
+	}
+}
+
+// sync buffer the stream while fetching a snapshot then apply the events on
+// top of it, it returns when the book is out of sync and tells if any event
+// could be applied
+func (b *OrderBook) sync(ctx context.Context) (applied bool, err error) {
+	eventC := make(chan *Event, eventBufferSize)
+	errC := make(chan error, 1)
+	fail := func(err error) {
+		select {
+		case errC <- err:
+		default:
+		}
+	}
+	doneC, stopC, err := b.source.Serve(b.symbol, func(event *Event) {
+		select {
+		case eventC <- event:
+		default:
+			fail(ErrBufferFull)
+		}
+	}, fail)
+	if err != nil {
+		return false, err
+	}
+	defer close(stopC)
+
+	snapshot, err := b.source.Snapshot(ctx, b.symbol, b.limit)
+	if err != nil {
+		return false, err
+	}
+	if err = b.load(snapshot); err != nil {
+		return false, err
+	}
+	b.notify()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return applied, ctx.Err()
+		case <-doneC:
+			return applied, ErrStreamClosed
+		case err = <-errC:
+			return applied, err
+		case event := <-eventC:
+			ok, err := b.apply(event, !applied)
+			if err != nil {
+				return applied, err
+			}
+			if ok {
+				applied = true
+				b.notify()
+			}
+		}
+	}
+}
+
+func (b *OrderBook) load(snapshot *Snapshot) error {
+	bids := side{desc: true}
+	asks := side{}
+	for _, pl := range snapshot.Bids {
+		if err := bids.set(pl); err != nil {
+			return err
+		}
+	}
+	for _, pl := range snapshot.Asks {
+		if err := asks.set(pl); err != nil {
+			return err
+		}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.bids = bids
+	b.asks = asks
+	b.lastUpdateID = snapshot.LastUpdateID
+	b.synced = true
+	return nil
+}
+
+// apply update the book with event, stale events are dropped and false is returned
+func (b *OrderBook) apply(event *Event, first bool) (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	last := b.lastUpdateID
+	prev := b.source.HasPrevUpdateID()
+	if event.LastUpdateID < last || (!prev && event.LastUpdateID == last) {
+		return false, nil
+	}
+	var inSequence bool
+	switch {
+	case first && prev:
+		inSequence = event.FirstUpdateID <= last
+	case first:
+		inSequence = event.FirstUpdateID <= last+1
+	case prev:
+		inSequence = event.PrevLastUpdateID == last
+	default:
+		inSequence = event.FirstUpdateID == last+1
+	}
+	if !inSequence {
+		return false, ErrSequenceGap
+	}
+	for _, pl := range event.Bids {
+		if err := b.bids.set(pl); err != nil {
+			return false, err
+		}
+	}
+	for _, pl := range event.Asks {
+		if err := b.asks.set(pl); err != nil {
+			return false, err
+		}
+	}
+	b.lastUpdateID = event.LastUpdateID
+	return true, nil
+}
+
+func (b *OrderBook) notify() {
+	if b.onUpdate != nil {
+		b.onUpdate(b)
+	}
+}
@@ -0,0 +1,265 @@
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type snapshotResult struct {
+	snapshot *Snapshot
+	err      error
+}
+
+type fakeStream struct {
+	handler    func(event *Event)
+	errHandler func(err error)
+	doneC      chan struct{}
+	stopC      chan struct{}
+}
+
+// fakeSource hands every stream to the test and blocks snapshots until the
+// test provides them
+type fakeSource struct {
+	prev      bool
+	streams   chan *fakeStream
+	snapshots chan snapshotResult
+}
+
+func newFakeSource(prev bool) *fakeSource {
+	return &fakeSource{
+		prev:      prev,
+		streams:   make(chan *fakeStream, 10),
+		snapshots: make(chan snapshotResult),
+	}
+}
+
+func (s *fakeSource) Snapshot(ctx context.Context, symbol string, limit int) (*Snapshot, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-s.snapshots:
+		return res.snapshot, res.err
+	}
+}
+
+func (s *fakeSource) Serve(symbol string, handler func(event *Event), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	stream := &fakeStream{
+		handler:    handler,
+		errHandler: errHandler,
+		doneC:      make(chan struct{}),
+		stopC:      make(chan struct{}),
+	}
+	go func() {
+		<-stream.stopC
+		close(stream.doneC)
+	}()
+	s.streams <- stream
+	return stream.doneC, stream.stopC, nil
+}
+
+func (s *fakeSource) HasPrevUpdateID() bool {
+	return s.prev
+}
+
+type orderBookTestSuite struct {
+	suite.Suite
+	source  *fakeSource
+	book    *OrderBook
+	updates chan int64
+	errs    chan error
+}
+
+func TestOrderBook(t *testing.T) {
+	suite.Run(t, new(orderBookTestSuite))
+}
+
+func (s *orderBookTestSuite) start(prev bool) *fakeStream {
+	s.source = newFakeSource(prev)
+	s.updates = make(chan int64, 100)
+	s.errs = make(chan error, 10)
+	s.book = NewOrderBook(s.source, "BTCUSDT").Limit(100).
+		Backoff(common.ConstantBackoff(0)).
+		OnUpdate(func(b *OrderBook) {
+			s.updates <- b.LastUpdateID()
+		}).
+		ErrHandler(func(err error) {
+			s.errs <- err
+		})
+	s.Require().NoError(s.book.Start(context.Background()))
+	return s.nextStream()
+}
+
+func (s *orderBookTestSuite) TearDownTest() {
+	if s.book != nil {
+		s.book.Stop()
+		<-s.book.Done()
+	}
+}
+
+func (s *orderBookTestSuite) nextStream() *fakeStream {
+	select {
+	case stream := <-s.source.streams:
+		return stream
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("stream not started")
+	}
+	return nil
+}
+
+func (s *orderBookTestSuite) assertUpdate(lastUpdateID int64) {
+	select {
+	case id := <-s.updates:
+		s.Require().Equal(lastUpdateID, id)
+	case <-time.After(5 * time.Second):
+		s.T().Fatalf("update %d not applied", lastUpdateID)
+	}
+}
+
+func (s *orderBookTestSuite) assertErr(err error) {
+	select {
+	case e := <-s.errs:
+		s.Require().Equal(err, e)
+	case <-time.After(5 * time.Second):
+		s.T().Fatalf("error %v not reported", err)
+	}
+}
+
+func levels(pairs ...string) []common.PriceLevel {
+	res := make([]common.PriceLevel, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		res = append(res, common.PriceLevel{Price: pairs[i], Quantity: pairs[i+1]})
+	}
+	return res
+}
+
+func (s *orderBookTestSuite) snapshot(lastUpdateID int64) {
+	s.source.snapshots <- snapshotResult{snapshot: &Snapshot{
+		LastUpdateID: lastUpdateID,
+		Bids:         levels("100.0", "1", "99.0", "2"),
+		Asks:         levels("101.0", "1", "102.0", "2"),
+	}}
+}
+
+func (s *orderBookTestSuite) TestSpotSync() {
+	r := s.Require()
+	stream := s.start(false)
+	// buffered while the snapshot is fetched
+	stream.handler(&Event{FirstUpdateID: 95, LastUpdateID: 100, Bids: levels("100.0", "5")})
+	stream.handler(&Event{FirstUpdateID: 101, LastUpdateID: 103, Bids: levels("99.5", "3", "99.0", "0")})
+	s.snapshot(100)
+	s.assertUpdate(100)
+	s.assertUpdate(103)
+
+	r.True(s.book.Synced())
+	r.Equal(levels("100.0", "1", "99.5", "3"), s.book.Bids(0))
+	r.Equal(levels("101.0", "1"), s.book.Asks(1))
+
+	stream.handler(&Event{FirstUpdateID: 104, LastUpdateID: 105, Asks: levels("100.5", "4", "101.0", "0")})
+	s.assertUpdate(105)
+	ask, ok := s.book.BestAsk()
+	r.True(ok)
+	r.Equal(common.PriceLevel{Price: "100.5", Quantity: "4"}, ask)
+	bid, ok := s.book.BestBid()
+	r.True(ok)
+	r.Equal(common.PriceLevel{Price: "100.0", Quantity: "1"}, bid)
+}
+
+func (s *orderBookTestSuite) TestSpotFirstEventGap() {
+	stream := s.start(false)
+	stream.handler(&Event{FirstUpdateID: 102, LastUpdateID: 103})
+	s.snapshot(100)
+	s.assertUpdate(100)
+	s.assertErr(ErrSequenceGap)
+	s.Require().False(s.book.Synced())
+	s.nextStream()
+}
+
+func (s *orderBookTestSuite) TestSpotGapResync() {
+	r := s.Require()
+	stream := s.start(false)
+	s.snapshot(100)
+	s.assertUpdate(100)
+	stream.handler(&Event{FirstUpdateID: 101, LastUpdateID: 102})
+	s.assertUpdate(102)
+
+	stream.handler(&Event{FirstUpdateID: 104, LastUpdateID: 105})
+	s.assertErr(ErrSequenceGap)
+	select {
+	case <-stream.doneC:
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("stream not stopped")
+	}
+
+	stream = s.nextStream()
+	stream.handler(&Event{FirstUpdateID: 104, LastUpdateID: 106})
+	s.snapshot(105)
+	s.assertUpdate(105)
+	s.assertUpdate(106)
+	r.True(s.book.Synced())
+}
+
+func (s *orderBookTestSuite) TestFuturesSync() {
+	r := s.Require()
+	stream := s.start(true)
+	stream.handler(&Event{FirstUpdateID: 90, LastUpdateID: 99, PrevLastUpdateID: 89})
+	stream.handler(&Event{FirstUpdateID: 98, LastUpdateID: 102, PrevLastUpdateID: 97, Asks: levels("101.0", "7")})
+	s.snapshot(100)
+	s.assertUpdate(100)
+	s.assertUpdate(102)
+	r.Equal(levels("101.0", "7", "102.0", "2"), s.book.Asks(0))
+
+	stream.handler(&Event{FirstUpdateID: 103, LastUpdateID: 105, PrevLastUpdateID: 102})
+	s.assertUpdate(105)
+
+	stream.handler(&Event{FirstUpdateID: 106, LastUpdateID: 107, PrevLastUpdateID: 104})
+	s.assertErr(ErrSequenceGap)
+	s.nextStream()
+}
+
+func (s *orderBookTestSuite) TestSnapshotError() {
+	stream := s.start(false)
+	err := errors.New("dummy error")
+	s.source.snapshots <- snapshotResult{err: err}
+	s.assertErr(err)
+	select {
+	case <-stream.doneC:
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("stream not stopped")
+	}
+	s.nextStream()
+	s.snapshot(100)
+	s.assertUpdate(100)
+}
+
+func (s *orderBookTestSuite) TestStreamError() {
+	stream := s.start(false)
+	s.snapshot(100)
+	s.assertUpdate(100)
+	err := errors.New("dummy error")
+	stream.errHandler(err)
+	s.assertErr(err)
+	s.nextStream()
+}
+
+func (s *orderBookTestSuite) TestStop() {
+	r := s.Require()
+	stream := s.start(false)
+	r.Error(s.book.Start(context.Background()))
+	s.book.Stop()
+	select {
+	case <-s.book.Done():
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("book not stopped")
+	}
+	select {
+	case <-stream.doneC:
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("stream not stopped")
+	}
+}
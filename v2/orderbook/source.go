@@ -0,0 +1,155 @@
+package orderbook
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/common"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+)
+
+// Event define a diff depth event in the format shared by all markets
+type Event struct {
+	Symbol           string
+	Time             int64
+	FirstUpdateID    int64
+	LastUpdateID     int64
+	PrevLastUpdateID int64 // only set by futures markets
+	Bids             []common.PriceLevel
+	Asks             []common.PriceLevel
+}
+
+// Snapshot define a depth snapshot fetched from the REST API
+type Snapshot struct {
+	LastUpdateID int64
+	Bids         []common.PriceLevel
+	Asks         []common.PriceLevel
+}
+
+// Source connects an order book to the depth snapshot and diff depth stream of a market
+type Source interface {
+	// Snapshot fetch the depth snapshot of symbol with at most limit levels on each side
+	Snapshot(ctx context.Context, symbol string, limit int) (*Snapshot, error)
+	// Serve start the diff depth stream of symbol
+	Serve(symbol string, handler func(event *Event), errHandler func(err error)) (doneC, stopC chan struct{}, err error)
+	// HasPrevUpdateID tells if events carry the last update id of the previous event,
+	// the sequence is then checked with it instead of the first update id
+	HasPrevUpdateID() bool
+}
+
+// SpotSource is the Source of the spot market
+type SpotSource struct {
+	c *binance.Client
+}
+
+// NewSpotSource init a source of the spot market
+func NewSpotSource(c *binance.Client) *SpotSource {
+	return &SpotSource{c: c}
+}
+
+// Snapshot implements Source
+func (s *SpotSource) Snapshot(ctx context.Context, symbol string, limit int) (*Snapshot, error) {
+	res, err := s.c.NewDepthService().Symbol(symbol).Limit(limit).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{LastUpdateID: res.LastUpdateID, Bids: res.Bids, Asks: res.Asks}, nil
+}
+
+// Serve implements Source
+func (s *SpotSource) Serve(symbol string, handler func(event *Event), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	return binance.WsDepthServe100Ms(symbol, func(e *binance.WsDepthEvent) {
+		handler(&Event{
+			Symbol:        e.Symbol,
+			Time:          e.Time,
+			FirstUpdateID: e.FirstUpdateID,
+			LastUpdateID:  e.LastUpdateID,
+			Bids:          e.Bids,
+			Asks:          e.Asks,
+		})
+	}, errHandler)
+}
+
+// HasPrevUpdateID implements Source
+func (s *SpotSource) HasPrevUpdateID() bool {
+	return false
+}
+
+// FuturesSource is the Source of the USD-M futures market
+type FuturesSource struct {
+	c *futures.Client
+}
+
+// NewFuturesSource init a source of the USD-M futures market
+func NewFuturesSource(c *futures.Client) *FuturesSource {
+	return &FuturesSource{c: c}
+}
+
+// Snapshot implements Source
+func (s *FuturesSource) Snapshot(ctx context.Context, symbol string, limit int) (*Snapshot, error) {
+	res, err := s.c.NewDepthService().Symbol(symbol).Limit(limit).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{LastUpdateID: res.LastUpdateID, Bids: res.Bids, Asks: res.Asks}, nil
+}
+
+// Serve implements Source
+func (s *FuturesSource) Serve(symbol string, handler func(event *Event), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	return futures.WsDiffDepthServe(symbol, func(e *futures.WsDepthEvent) {
+		handler(&Event{
+			Symbol:           e.Symbol,
+			Time:             e.Time,
+			FirstUpdateID:    e.FirstUpdateID,
+			LastUpdateID:     e.LastUpdateID,
+			PrevLastUpdateID: e.PrevLastUpdateID,
+			Bids:             e.Bids,
+			Asks:             e.Asks,
+		})
+	}, errHandler)
+}
+
+// HasPrevUpdateID implements Source
+func (s *FuturesSource) HasPrevUpdateID() bool {
+	return true
+}
+
+// DeliverySource is the Source of the COIN-M futures market
+type DeliverySource struct {
+	c *delivery.Client
+}
+
+// NewDeliverySource init a source of the COIN-M futures market
+func NewDeliverySource(c *delivery.Client) *DeliverySource {
+	return &DeliverySource{c: c}
+}
+
+// Snapshot implements Source
+func (s *DeliverySource) Snapshot(ctx context.Context, symbol string, limit int) (*Snapshot, error) {
+	res, err := s.c.NewDepthService().Symbol(symbol).Limit(limit).Do(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{LastUpdateID: res.LastUpdateID, Bids: res.Bids, Asks: res.Asks}, nil
+}
+
+// Serve implements Source
+func (s *DeliverySource) Serve(symbol string, handler func(event *Event), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+	return delivery.WsDiffDepthServe(symbol, func(e *delivery.WsDepthEvent) {
+		handler(&Event{
+			Symbol:           e.Symbol,
+			Time:             e.Time,
+			FirstUpdateID:    e.FirstUpdateID,
+			LastUpdateID:     e.LastUpdateID,
+			PrevLastUpdateID: e.PrevLastUpdateID,
+			Bids:             e.Bids,
+			Asks:             e.Asks,
+		})
+	}, errHandler)
+}
+
+// HasPrevUpdateID implements Source
+func (s *DeliverySource) HasPrevUpdateID() bool {
+	return true
+}
@@ -0,0 +1,50 @@
+package pmargin
+
+import (
+	"strings"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// DefaultRateLimitRules define the limits of the portfolio margin API,
+// there is no exchangeInfo endpoint to load them from
+var DefaultRateLimitRules = []common.RateLimitRule{
+	{Type: common.RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 6000},
+	{Type: common.RateLimitTypeOrders, Interval: time.Minute, Limit: 1200},
+}
+
+// requestWeights define the weight of the /papi endpoints which do not weigh 1
+var requestWeights = map[string]int64{
+	"GET /papi/v1/account":           20,
+	"GET /papi/v1/balance":           20,
+	"GET /papi/v1/um/account":        5,
+	"GET /papi/v1/um/positionRisk":   5,
+	"GET /papi/v1/um/income":         30,
+	"GET /papi/v1/um/userTrades":     5,
+	"GET /papi/v1/margin/order":      10,
+	"GET /papi/v1/margin/openOrders": 5,
+}
+
+// orderCounts define the orders counted against the ORDERS limits by the endpoints placing orders
+var orderCounts = map[string]int64{
+	"POST /papi/v1/um/order":     1,
+	"POST /papi/v1/margin/order": 1,
+}
+
+// requestCost return what r counts against the /papi limits, false if r is
+// not counted against them
+func requestCost(r *request) (common.RequestCost, bool) {
+	if !strings.HasPrefix(r.endpoint, "/papi/") {
+		return common.RequestCost{}, false
+	}
+	key := r.method + " " + r.endpoint
+	cost := common.RequestCost{Weight: 1, Orders: orderCounts[key]}
+	if w, ok := requestWeights[key]; ok {
+		cost.Weight = w
+	}
+	if key == "GET /papi/v1/um/openOrders" && r.query.Get("symbol") == "" {
+		cost.Weight = 40
+	}
+	return cost, true
+}
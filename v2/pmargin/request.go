@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type secType int
@@ -29,6 +31,7 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+	usage      *common.Usage
 }
 
 func setValue(values url.Values, k string, v interface{}) {
@@ -122,6 +125,13 @@ func WithHeaders(header http.Header) RequestOption {
 	}
 }
 
+// WithUsage fill usage with the rate limit usage reported by the response
+func WithUsage(usage *common.Usage) RequestOption {
+	return func(r *request) {
+		r.usage = usage
+	}
+}
+
 // WithExtraForm add extra form data of the request
 func WithExtraForm(m map[string]any) RequestOption {
 	return func(r *request) {
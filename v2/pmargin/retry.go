@@ -0,0 +1,22 @@
+package pmargin
+
+import (
+	"net/http"
+	"strings"
+)
+
+// retryable tells if r can be sent again safely: queries, listen key
+// keepalives and orders identified by a client order id
+func retryable(r *request) bool {
+	switch r.method {
+	case http.MethodGet:
+		return true
+	case http.MethodPut:
+		return strings.HasSuffix(r.endpoint, "/listenKey")
+	case http.MethodPost:
+		if _, ok := orderCounts[r.method+" "+r.endpoint]; ok {
+			return r.query.Get("newClientOrderId") != "" || r.form.Get("newClientOrderId") != ""
+		}
+	}
+	return false
+}
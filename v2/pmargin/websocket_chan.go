@@ -0,0 +1,15 @@
+package pmargin
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsUserDataServeChan serve the user data stream of listenKey like WsUserDataServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsUserDataServeChan(ctx context.Context, listenKey string, opts ...common.ChanOption) (<-chan *WsUserDataEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
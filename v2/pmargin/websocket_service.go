@@ -19,6 +19,8 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect enables reconnecting dropped streams with the given policy, nil disables it
+	WebsocketReconnect *WsReconnectConfig
 	ProxyUrl           = ""
 )
 
@@ -247,8 +249,11 @@ func WsUserDataServe(
 	handler func(event *WsUserDataEvent),
 	errHandler ErrHandler,
 ) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)), handler, errHandler)
+}
+
+// wsUserDataServe serve the user data stream with cfg
+func wsUserDataServe(cfg *WsConfig, handler func(event *WsUserDataEvent), errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		event := new(WsUserDataEvent)
 		err := json.Unmarshal(message, event)
@@ -0,0 +1,106 @@
+package binance
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// requestWeights define the weight of the /api endpoints which do not weigh 1
+var requestWeights = map[string]int64{
+	"GET /api/v3/exchangeInfo":       20,
+	"GET /api/v3/trades":             25,
+	"GET /api/v3/historicalTrades":   25,
+	"GET /api/v3/aggTrades":          2,
+	"GET /api/v3/klines":             2,
+	"GET /api/v3/uiKlines":           2,
+	"GET /api/v3/avgPrice":           2,
+	"GET /api/v3/ticker":             4,
+	"GET /api/v3/ticker/tradingDay":  4,
+	"GET /api/v3/order":              4,
+	"GET /api/v3/allOrders":          20,
+	"GET /api/v3/orderList":          4,
+	"GET /api/v3/openOrderList":      6,
+	"GET /api/v3/account":            20,
+	"GET /api/v3/myTrades":           20,
+	"GET /api/v3/rateLimit/order":    40,
+	"GET /api/v3/myPreventedMatches": 20,
+	"GET /api/v3/myAllocations":      20,
+	"POST /api/v3/userDataStream":    2,
+	"PUT /api/v3/userDataStream":     2,
+	"DELETE /api/v3/userDataStream":  2,
+	"GET /api/v3/ticker/price":       2,
+	"GET /api/v3/ticker/bookTicker":  2,
+	"GET /api/v3/ticker/24hr":        2,
+	"GET /api/v3/openOrders":         6,
+	"GET /api/v3/depth":              5,
+}
+
+// orderCounts define the orders counted against the ORDERS limits by the endpoints placing orders
+var orderCounts = map[string]int64{
+	"POST /api/v3/order":               1,
+	"POST /api/v3/order/cancelReplace": 1,
+	"POST /api/v3/sor/order":           1,
+	"POST /api/v3/order/oco":           2,
+	"POST /api/v3/orderList/oco":       2,
+	"POST /api/v3/orderList/oto":       2,
+	"POST /api/v3/orderList/otoco":     3,
+}
+
+// requestCost return what r counts against the /api limits, false if r is
+// not counted against them (e.g. /sapi endpoints have limits of their own)
+func requestCost(r *request) (common.RequestCost, bool) {
+	if !strings.HasPrefix(r.endpoint, "/api/") {
+		return common.RequestCost{}, false
+	}
+	key := r.method + " " + r.endpoint
+	cost := common.RequestCost{Weight: 1, Orders: orderCounts[key]}
+	if w, ok := requestWeights[key]; ok {
+		cost.Weight = w
+	}
+	switch key {
+	case "GET /api/v3/depth":
+		limit, _ := strconv.Atoi(r.query.Get("limit"))
+		switch {
+		case limit > 1000:
+			cost.Weight = 250
+		case limit > 500:
+			cost.Weight = 50
+		case limit > 100:
+			cost.Weight = 25
+		}
+	case "GET /api/v3/ticker/24hr", "GET /api/v3/ticker/price", "GET /api/v3/ticker/bookTicker":
+		if r.query.Get("symbol") != "" {
+			break
+		}
+		symbols := r.query.Get("symbols")
+		n := strings.Count(symbols, ",") + 1
+		switch {
+		case key != "GET /api/v3/ticker/24hr":
+			cost.Weight = 4
+		case symbols == "" || n > 100:
+			cost.Weight = 80
+		case n > 20:
+			cost.Weight = 40
+		}
+	case "GET /api/v3/openOrders":
+		if r.query.Get("symbol") == "" {
+			cost.Weight = 80
+		}
+	}
+	return cost, true
+}
+
+// RateLimitRules return the rate limits as rules of a common.RateLimiter
+func (e *ExchangeInfo) RateLimitRules() ([]common.RateLimitRule, error) {
+	rules := make([]common.RateLimitRule, 0, len(e.RateLimits))
+	for _, l := range e.RateLimits {
+		rule, err := common.NewRateLimitRule(l.RateLimitType, l.Interval, l.IntervalNum, l.Limit)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
@@ -0,0 +1,127 @@
+package binance
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type rateLimitTestSuite struct {
+	baseTestSuite
+}
+
+func TestRateLimit(t *testing.T) {
+	suite.Run(t, new(rateLimitTestSuite))
+}
+
+func (s *rateLimitTestSuite) TestRequestCost() {
+	r := s.r()
+	for _, c := range []struct {
+		method   string
+		endpoint string
+		query    url.Values
+		cost     common.RequestCost
+		ok       bool
+	}{
+		{http.MethodGet, "/api/v3/ping", nil, common.RequestCost{Weight: 1}, true},
+		{http.MethodGet, "/api/v3/depth", nil, common.RequestCost{Weight: 5}, true},
+		{http.MethodGet, "/api/v3/depth", url.Values{"limit": {"1000"}}, common.RequestCost{Weight: 50}, true},
+		{http.MethodGet, "/api/v3/depth", url.Values{"limit": {"5000"}}, common.RequestCost{Weight: 250}, true},
+		{http.MethodGet, "/api/v3/ticker/24hr", url.Values{"symbol": {"BTCUSDT"}}, common.RequestCost{Weight: 2}, true},
+		{http.MethodGet, "/api/v3/ticker/24hr", nil, common.RequestCost{Weight: 80}, true},
+		{http.MethodGet, "/api/v3/ticker/price", nil, common.RequestCost{Weight: 4}, true},
+		{http.MethodGet, "/api/v3/openOrders", nil, common.RequestCost{Weight: 80}, true},
+		{http.MethodGet, "/api/v3/order", nil, common.RequestCost{Weight: 4}, true},
+		{http.MethodGet, "/api/v3/myPreventedMatches", nil, common.RequestCost{Weight: 20}, true},
+		{http.MethodPost, "/api/v3/order", nil, common.RequestCost{Weight: 1, Orders: 1}, true},
+		{http.MethodPost, "/api/v3/sor/order", nil, common.RequestCost{Weight: 1, Orders: 1}, true},
+		{http.MethodPost, "/api/v3/order/oco", nil, common.RequestCost{Weight: 1, Orders: 2}, true},
+		{http.MethodPost, "/api/v3/orderList/otoco", nil, common.RequestCost{Weight: 1, Orders: 3}, true},
+		{http.MethodGet, "/sapi/v1/margin/account", nil, common.RequestCost{}, false},
+	} {
+		cost, ok := requestCost(&request{method: c.method, endpoint: c.endpoint, query: c.query})
+		r.Equal(c.ok, ok, c.endpoint)
+		r.Equal(c.cost, cost, c.endpoint)
+	}
+}
+
+func (s *rateLimitTestSuite) TestRateLimitRules() {
+	info := &ExchangeInfo{RateLimits: []RateLimit{
+		{RateLimitType: "REQUEST_WEIGHT", Interval: "MINUTE", IntervalNum: 1, Limit: 6000},
+		{RateLimitType: "ORDERS", Interval: "SECOND", IntervalNum: 10, Limit: 100},
+	}}
+	rules, err := info.RateLimitRules()
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]common.RateLimitRule{
+		{Type: common.RateLimitTypeRequestWeight, Interval: time.Minute, Limit: 6000},
+		{Type: common.RateLimitTypeOrders, Interval: 10 * time.Second, Limit: 100},
+	}, rules)
+}
+
+func (s *rateLimitTestSuite) TestFailFast() {
+	s.mockDo([]byte(`{}`), nil)
+	defer s.assertDo()
+	s.client.RateLimiter = common.NewRateLimiter(common.RateLimitRule{
+		Type:     common.RateLimitTypeOrders,
+		Interval: 24 * time.Hour,
+		Limit:    1,
+	})
+	s.client.RateLimiter.FailFast = true
+	r := s.r()
+
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	r.NoError(err)
+	_, err = s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	r.IsType(common.RateLimitError{}, err)
+	s.client.AssertNumberOfCalls(s.T(), "do", 1)
+}
+
+func (s *rateLimitTestSuite) TestUpdateFromHeaders() {
+	s.client.RateLimiter = common.NewRateLimiter(common.RateLimitRule{
+		Type:     common.RateLimitTypeRequestWeight,
+		Interval: time.Minute,
+		Limit:    6000,
+	})
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("X-MBX-USED-WEIGHT-1M", "1234")
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{}`)),
+			StatusCode: http.StatusOK,
+			Header:     header,
+		}, nil
+	}
+	err := s.client.NewPingService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1234), s.client.RateLimiter.Used(common.RateLimitTypeRequestWeight, time.Minute))
+}
+
+func (s *rateLimitTestSuite) TestWithUsage() {
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		header := http.Header{}
+		header.Set("X-MBX-USED-WEIGHT-1M", "429")
+		header.Set("Retry-After", "7")
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"code":-1003,"msg":"Too many requests."}`)),
+			StatusCode: http.StatusTooManyRequests,
+			Header:     header,
+		}, nil
+	}
+	usage := new(common.Usage)
+	err := s.client.NewPingService().Do(newContext(), WithUsage(usage))
+	r := s.r()
+	r.Error(err)
+	r.Equal(int64(429), usage.UsedWeight["1M"])
+	r.Equal(7*time.Second, usage.RetryAfter)
+}
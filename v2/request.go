@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"net/url"
 	"reflect"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 type secType int
@@ -29,6 +31,7 @@ type request struct {
 	header     http.Header
 	body       io.Reader
 	fullURL    string
+	usage      *common.Usage
 }
 
 // addParam add param with key/value to query string
@@ -122,3 +125,10 @@ func WithHeaders(header http.Header) RequestOption {
 		r.header = header.Clone()
 	}
 }
+
+// WithUsage fill usage with the rate limit usage reported by the response
+func WithUsage(usage *common.Usage) RequestOption {
+	return func(r *request) {
+		r.usage = usage
+	}
+}
@@ -0,0 +1,28 @@
+package binance
+
+import (
+	"net/http"
+	"strings"
+)
+
+// retryable tells if r can be sent again safely: queries, listen key
+// keepalives and orders identified by a client order id
+func retryable(r *request) bool {
+	switch r.method {
+	case http.MethodGet:
+		return true
+	case http.MethodPut:
+		return strings.HasSuffix(r.endpoint, "/userDataStream") ||
+			strings.HasSuffix(r.endpoint, "/userDataStream/isolated")
+	case http.MethodPost:
+		key := r.method + " " + r.endpoint
+		if _, ok := orderCounts[key]; ok || key == "POST /sapi/v1/margin/order" {
+			return hasParam(r, "newClientOrderId") || hasParam(r, "listClientOrderId")
+		}
+	}
+	return false
+}
+
+func hasParam(r *request, key string) bool {
+	return r.query.Get(key) != "" || r.form.Get(key) != ""
+}
@@ -0,0 +1,136 @@
+package binance
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type retryTestSuite struct {
+	baseTestSuite
+	attempts int
+}
+
+func TestRetry(t *testing.T) {
+	suite.Run(t, new(retryTestSuite))
+}
+
+// mockResponses answer the attempts in turn, a nil response is a network error
+func (s *retryTestSuite) mockResponses(responses ...*http.Response) {
+	s.attempts = 0
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		res := responses[s.attempts]
+		s.attempts++
+		if res == nil {
+			return nil, &url.Error{Op: req.Method, URL: req.URL.String(), Err: errors.New("connection reset by peer")}
+		}
+		return res, nil
+	}
+	s.client.RetryPolicy = &common.RetryPolicy{MaxAttempts: 3, Backoff: common.ConstantBackoff(0)}
+}
+
+func newRetryResponse(statusCode int, body string, header http.Header) *http.Response {
+	return &http.Response{
+		Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+		StatusCode: statusCode,
+		Header:     header,
+	}
+}
+
+func (s *retryTestSuite) TestRetryQuery() {
+	s.mockResponses(
+		nil,
+		newRetryResponse(http.StatusBadGateway, "", nil),
+		newRetryResponse(http.StatusOK, `{"serverTime": 1499827319559}`, nil),
+	)
+	serverTime, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal(int64(1499827319559), serverTime)
+	r.Equal(3, s.attempts)
+}
+
+func (s *retryTestSuite) TestGiveUp() {
+	s.mockResponses(nil, nil, nil)
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	r.Error(err)
+	r.Equal(3, s.attempts)
+}
+
+func (s *retryTestSuite) TestRetryAfter() {
+	header := http.Header{}
+	header.Set("Retry-After", "0")
+	s.mockResponses(
+		newRetryResponse(http.StatusTooManyRequests, `{"code":-1003,"msg":"Too many requests."}`, header),
+		newRetryResponse(http.StatusOK, `{"serverTime": 1499827319559}`, nil),
+	)
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().NoError(err)
+	s.r().Equal(2, s.attempts)
+}
+
+func (s *retryTestSuite) TestNoRetryOnClientError() {
+	s.mockResponses(
+		newRetryResponse(http.StatusBadRequest, `{"code":-1121,"msg":"Invalid symbol."}`, nil),
+	)
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	s.r().Equal(&common.APIError{Code: -1121, Message: "Invalid symbol.", StatusCode: http.StatusBadRequest}, err)
+	s.r().Equal(1, s.attempts)
+}
+
+func (s *retryTestSuite) TestOrderWithoutClientOrderID() {
+	s.mockResponses(nil, nil)
+	_, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").Do(newContext())
+	s.r().Error(err)
+	s.r().Equal(1, s.attempts)
+}
+
+func (s *retryTestSuite) TestOrderWithClientOrderID() {
+	var timestamps []string
+	s.mockResponses(nil, newRetryResponse(http.StatusOK, `{"symbol":"BTCUSDT","clientOrderId":"myOrder1"}`, nil))
+	do := s.client.Client.do
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		body, _ := ioutil.ReadAll(req.Body)
+		form, _ := url.ParseQuery(string(body))
+		s.r().Equal("myOrder1", form.Get("newClientOrderId"))
+		timestamps = append(timestamps, req.URL.Query().Get(timestampKey))
+		time.Sleep(2 * time.Millisecond)
+		return do(req)
+	}
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeBuy).
+		Type(OrderTypeMarket).Quantity("1").NewClientOrderID("myOrder1").Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal("myOrder1", res.ClientOrderID)
+	r.Equal(2, s.attempts)
+	// every attempt is signed again
+	r.Len(timestamps, 2)
+	r.NotEqual(timestamps[0], timestamps[1])
+}
+
+func (s *retryTestSuite) TestIPBanned() {
+	header := http.Header{}
+	header.Set("Retry-After", "120")
+	s.mockResponses(
+		newRetryResponse(http.StatusTeapot, `{"code":-1003,"msg":"Way too many requests; IP banned."}`, header),
+	)
+	s.client.RetryPolicy.MaxRetryAfter = time.Minute
+	_, err := s.client.NewServerTimeService().Do(newContext())
+	r := s.r()
+	r.True(common.IsIPBanned(err))
+	r.True(errors.Is(err, common.ErrTooManyRequests))
+	retryAfter, ok := common.RetryAfter(err)
+	r.True(ok)
+	r.Equal(2*time.Minute, retryAfter)
+	r.Equal(1, s.attempts)
+}
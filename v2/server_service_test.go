@@ -1,9 +1,12 @@
 package binance
 
 import (
+	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/adshao/go-binance/v2/common"
 	"github.com/stretchr/testify/suite"
@@ -105,3 +108,53 @@ func (s *serverServiceTestSuite) TestSetServerTime() {
 	s.r().NotZero(s.client.TimeOffset)
 	s.r().EqualValues(timeOffset, s.client.TimeOffset)
 }
+
+func (s *serverServiceTestSuite) TestStartTimeSync() {
+	s.mockDo([]byte(`{"serverTime": 1499827319559}`), nil)
+	defer s.assertDo()
+
+	err := s.client.StartTimeSync(newContext(), time.Hour)
+	r := s.r()
+	r.NoError(err)
+	defer s.client.TimeSync.Stop()
+	r.InDelta(currentTimestamp()-1499827319559, s.client.TimeSync.Offset(), 1000)
+}
+
+func (s *serverServiceTestSuite) TestResyncOnInvalidTimestamp() {
+	syncs := 0
+	s.client.TimeSync = common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		syncs++
+		return currentTimestamp() - 5000, nil
+	})
+	var timestamps []int64
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		timestamp, _ := strconv.ParseInt(req.URL.Query().Get(timestampKey), 10, 64)
+		timestamps = append(timestamps, timestamp)
+		if len(timestamps) == 1 {
+			return newHTTPResponse([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`), http.StatusBadRequest), nil
+		}
+		return newHTTPResponse([]byte(`{"canTrade": true}`), http.StatusOK), nil
+	}
+
+	account, err := s.client.NewGetAccountService().Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.True(account.CanTrade)
+	r.Equal(1, syncs)
+	r.Len(timestamps, 2)
+	r.InDelta(timestamps[0]-5000, timestamps[1], 1000)
+}
+
+func (s *serverServiceTestSuite) TestResyncOnlyOnce() {
+	s.client.TimeSync = common.NewTimeSync(func(ctx context.Context) (int64, error) {
+		return currentTimestamp(), nil
+	})
+	attempts := 0
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return newHTTPResponse([]byte(`{"code":-1021,"msg":"Timestamp for this request is outside of the recvWindow."}`), http.StatusBadRequest), nil
+	}
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.r().True(common.IsTimestampOutOfRecvWindow(err))
+	s.r().Equal(2, attempts)
+}
@@ -0,0 +1,182 @@
+package binance
+
+import (
+	"context"
+	"net/http"
+)
+
+// CreateSOROrderService create an order using smart order routing (SOR),
+// only LIMIT and MARKET orders are supported
+type CreateSOROrderService struct {
+	c                       *Client
+	symbol                  string
+	side                    SideType
+	orderType               OrderType
+	timeInForce             *TimeInForceType
+	quantity                string
+	price                   *string
+	newClientOrderID        *string
+	strategyID              *int64
+	strategyType            *int64
+	icebergQuantity         *string
+	newOrderRespType        *NewOrderRespType
+	selfTradePreventionMode *STPModeType
+}
+
+// Symbol set symbol
+func (s *CreateSOROrderService) Symbol(symbol string) *CreateSOROrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Side set side
+func (s *CreateSOROrderService) Side(side SideType) *CreateSOROrderService {
+	s.side = side
+	return s
+}
+
+// Type set type
+func (s *CreateSOROrderService) Type(orderType OrderType) *CreateSOROrderService {
+	s.orderType = orderType
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *CreateSOROrderService) TimeInForce(timeInForce TimeInForceType) *CreateSOROrderService {
+	s.timeInForce = &timeInForce
+	return s
+}
+
+// Quantity set quantity
+func (s *CreateSOROrderService) Quantity(quantity string) *CreateSOROrderService {
+	s.quantity = quantity
+	return s
+}
+
+// Price set price
+func (s *CreateSOROrderService) Price(price string) *CreateSOROrderService {
+	s.price = &price
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *CreateSOROrderService) NewClientOrderID(newClientOrderID string) *CreateSOROrderService {
+	s.newClientOrderID = &newClientOrderID
+	return s
+}
+
+// StrategyID set strategyId
+func (s *CreateSOROrderService) StrategyID(strategyID int64) *CreateSOROrderService {
+	s.strategyID = &strategyID
+	return s
+}
+
+// StrategyType set strategyType, it must be at least 1000000
+func (s *CreateSOROrderService) StrategyType(strategyType int64) *CreateSOROrderService {
+	s.strategyType = &strategyType
+	return s
+}
+
+// IcebergQuantity set icebergQuantity
+func (s *CreateSOROrderService) IcebergQuantity(icebergQuantity string) *CreateSOROrderService {
+	s.icebergQuantity = &icebergQuantity
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *CreateSOROrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *CreateSOROrderService {
+	s.newOrderRespType = &newOrderRespType
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *CreateSOROrderService) SelfTradePreventionMode(mode STPModeType) *CreateSOROrderService {
+	s.selfTradePreventionMode = &mode
+	return s
+}
+
+func (s *CreateSOROrderService) createOrder(ctx context.Context, endpoint string, opts ...RequestOption) (data []byte, err error) {
+	r := &request{
+		method:   http.MethodPost,
+		endpoint: endpoint,
+		secType:  secTypeSigned,
+	}
+	m := params{
+		"symbol":   s.symbol,
+		"side":     s.side,
+		"type":     s.orderType,
+		"quantity": s.quantity,
+	}
+	if s.timeInForce != nil {
+		m["timeInForce"] = *s.timeInForce
+	}
+	if s.price != nil {
+		m["price"] = *s.price
+	}
+	if s.newClientOrderID != nil {
+		m["newClientOrderId"] = *s.newClientOrderID
+	}
+	if s.strategyID != nil {
+		m["strategyId"] = *s.strategyID
+	}
+	if s.strategyType != nil {
+		m["strategyType"] = *s.strategyType
+	}
+	if s.icebergQuantity != nil {
+		m["icebergQty"] = *s.icebergQuantity
+	}
+	if s.newOrderRespType != nil {
+		m["newOrderRespType"] = *s.newOrderRespType
+	}
+	if s.selfTradePreventionMode != nil {
+		m["selfTradePreventionMode"] = *s.selfTradePreventionMode
+	}
+	r.setFormParams(m)
+	return s.c.callAPI(ctx, r, opts...)
+}
+
+// Do send request
+func (s *CreateSOROrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateSOROrderResponse, err error) {
+	data, err := s.createOrder(ctx, "/api/v3/sor/order", opts...)
+	if err != nil {
+		return nil, err
+	}
+	res = new(CreateSOROrderResponse)
+	err = json.Unmarshal(data, res)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Test send test api to check if the request is valid
+func (s *CreateSOROrderService) Test(ctx context.Context, opts ...RequestOption) (err error) {
+	_, err = s.createOrder(ctx, "/api/v3/sor/order/test", opts...)
+	return err
+}
+
+// CreateSOROrderResponse define create SOR order response
+type CreateSOROrderResponse struct {
+	Symbol                   string          `json:"symbol"`
+	OrderID                  int64           `json:"orderId"`
+	OrderListID              int64           `json:"orderListId"`
+	ClientOrderID            string          `json:"clientOrderId"`
+	TransactTime             int64           `json:"transactTime"`
+	Price                    string          `json:"price"`
+	OrigQuantity             string          `json:"origQty"`
+	ExecutedQuantity         string          `json:"executedQty"`
+	CummulativeQuoteQuantity string          `json:"cummulativeQuoteQty"`
+	Status                   OrderStatusType `json:"status"`
+	TimeInForce              TimeInForceType `json:"timeInForce"`
+	Type                     OrderType       `json:"type"`
+	Side                     SideType        `json:"side"`
+	StrategyID               int64           `json:"strategyId"`
+	StrategyType             int64           `json:"strategyType"`
+	WorkingTime              int64           `json:"workingTime"`
+	WorkingFloor             string          `json:"workingFloor"`
+	SelfTradePreventionMode  STPModeType     `json:"selfTradePreventionMode"`
+	UsedSor                  bool            `json:"usedSor"`
+
+	// for order response is set to FULL, the fills of each allocation
+	Fills []*Fill `json:"fills"`
+}
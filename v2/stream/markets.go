@@ -0,0 +1,72 @@
+package stream
+
+import (
+	"github.com/adshao/go-binance/v2"
+	"github.com/adshao/go-binance/v2/delivery"
+	"github.com/adshao/go-binance/v2/futures"
+	"github.com/adshao/go-binance/v2/options"
+)
+
+// Endpoints of the combined streams
+var (
+	SpotMainURL        = "wss://stream.binance.com:9443/stream"
+	SpotTestnetURL     = "wss://testnet.binance.vision/stream"
+	FuturesMainURL     = "wss://fstream.binance.com/stream"
+	FuturesTestnetURL  = "wss://stream.binancefuture.com/stream"
+	DeliveryMainURL    = "wss://dstream.binance.com/stream"
+	DeliveryTestnetURL = "wss://dstream.binancefuture.com/stream"
+	OptionsMainURL     = "wss://nbstream.binance.com/eoptions/stream"
+)
+
+func proxy(url string) *string {
+	if url == "" {
+		return nil
+	}
+	return &url
+}
+
+// NewSpotManager init a manager of the spot streams, it follows the UseTestnet
+// and ProxyUrl settings of the binance package
+func NewSpotManager() *Manager {
+	m := NewManager(SpotMainURL)
+	if binance.UseTestnet {
+		m.Endpoint = SpotTestnetURL
+	}
+	m.Proxy = proxy(binance.ProxyUrl)
+	return m
+}
+
+// NewFuturesManager init a manager of the USD-M futures streams, it follows the
+// UseTestnet and ProxyUrl settings of the futures package
+func NewFuturesManager() *Manager {
+	m := NewManager(FuturesMainURL)
+	if futures.UseTestnet {
+		m.Endpoint = FuturesTestnetURL
+	}
+	m.Proxy = proxy(futures.ProxyUrl)
+	m.MaxStreams = 200
+	m.MessagesPerSecond = 10
+	return m
+}
+
+// NewDeliveryManager init a manager of the COIN-M futures streams, it follows the
+// UseTestnet and ProxyUrl settings of the delivery package
+func NewDeliveryManager() *Manager {
+	m := NewManager(DeliveryMainURL)
+	if delivery.UseTestnet {
+		m.Endpoint = DeliveryTestnetURL
+	}
+	m.Proxy = proxy(delivery.ProxyUrl)
+	m.MaxStreams = 200
+	m.MessagesPerSecond = 10
+	return m
+}
+
+// NewOptionsManager init a manager of the options streams, it follows the
+// ProxyUrl setting of the options package
+func NewOptionsManager() *Manager {
+	m := NewManager(OptionsMainURL)
+	m.Proxy = proxy(options.ProxyUrl)
+	m.MaxStreams = 200
+	return m
+}
@@ -0,0 +1,571 @@
+// Package stream multiplexes market streams over a few combined stream
+// connections per market, streams are subscribed and unsubscribed at runtime
+// with the SUBSCRIBE and UNSUBSCRIBE messages of the websocket market streams.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+const (
+	defaultMaxStreams        = 1024
+	defaultMessagesPerSecond = 5
+	resubscribeTimeout       = 30 * time.Second
+)
+
+var (
+	// ErrManagerClosed is returned when the manager is used after Close
+	ErrManagerClosed = errors.New("stream: manager closed")
+	// ErrNotConnected is returned when the connection of a stream is lost before its request is answered
+	ErrNotConnected = errors.New("stream: not connected")
+)
+
+// Handler handle the data of a stream
+type Handler func(data []byte)
+
+// Decode return a Handler decoding the data of a stream into an event of type
+// T, e.g. stream.Decode(func(e *binance.WsAggTradeEvent) {...}, errHandler)
+func Decode[T any](handler func(event *T), errHandler func(err error)) Handler {
+	return func(data []byte) {
+		event := new(T)
+		if err := json.Unmarshal(data, event); err != nil {
+			if errHandler != nil {
+				errHandler(err)
+			}
+			return
+		}
+		handler(event)
+	}
+}
+
+// Manager keeps the subscribed streams of one market on as few combined stream
+// connections as the stream limit allows, and routes the data of each stream to
+// its handler. A dropped connection is dialed again and its streams resubscribed.
+type Manager struct {
+	// Endpoint of the combined streams of the market
+	Endpoint string
+	// Proxy url of the connections
+	Proxy *string
+	// MaxStreams is the number of streams per connection
+	MaxStreams int
+	// MessagesPerSecond is the number of messages sent per second on a connection
+	MessagesPerSecond int64
+	// Backoff decides the delay before reconnecting, common.DefaultBackoff is used if nil
+	Backoff common.BackoffPolicy
+	// ErrHandler is called when a connection is lost or fails to resubscribe its streams
+	ErrHandler func(err error)
+
+	mu       sync.RWMutex
+	conns    []*conn
+	owners   map[string]*conn
+	handlers map[string]Handler
+	closed   bool
+}
+
+// NewManager init a manager of the combined streams of endpoint
+func NewManager(endpoint string) *Manager {
+	return &Manager{
+		Endpoint:          endpoint,
+		MaxStreams:        defaultMaxStreams,
+		MessagesPerSecond: defaultMessagesPerSecond,
+	}
+}
+
+// Subscribe subscribe to streams, e.g. btcusdt@aggTrade, and route their data to
+// handler. The handler of a stream already subscribed is replaced.
+func (m *Manager) Subscribe(ctx context.Context, handler Handler, streams ...string) error {
+	m.mu.Lock()
+	if m.closed {
+		m.mu.Unlock()
+		return ErrManagerClosed
+	}
+	if m.owners == nil {
+		m.owners = make(map[string]*conn)
+		m.handlers = make(map[string]Handler)
+	}
+	var batches []*batch
+	var dials []*conn
+	for _, s := range streams {
+		if _, ok := m.owners[s]; ok {
+			m.handlers[s] = handler
+			continue
+		}
+		c := m.connWithRoom()
+		if c == nil {
+			c = newConn(m)
+			m.conns = append(m.conns, c)
+			dials = append(dials, c)
+		}
+		c.streams[s] = true
+		m.owners[s] = c
+		m.handlers[s] = handler
+		batches = addToBatch(batches, c, s)
+	}
+	m.mu.Unlock()
+
+	var err error
+	for i, c := range dials {
+		if err = c.connect(); err != nil {
+			// the next connections are never dialed, release their waiters
+			for _, o := range dials[i+1:] {
+				o.abandon()
+			}
+			break
+		}
+	}
+	for _, b := range batches {
+		if err != nil {
+			break
+		}
+		_, err = b.c.request(ctx, "SUBSCRIBE", b.streams)
+	}
+	if err != nil {
+		for _, b := range batches {
+			m.forget(b.streams)
+		}
+		m.closeIdle()
+		return err
+	}
+	return nil
+}
+
+// Unsubscribe unsubscribe from streams, the connections left without stream are closed
+func (m *Manager) Unsubscribe(ctx context.Context, streams ...string) error {
+	m.mu.Lock()
+	var batches []*batch
+	for _, s := range streams {
+		if c, ok := m.owners[s]; ok {
+			batches = addToBatch(batches, c, s)
+		}
+	}
+	m.mu.Unlock()
+	var err error
+	for _, b := range batches {
+		m.forget(b.streams)
+		if _, e := b.c.request(ctx, "UNSUBSCRIBE", b.streams); e != nil && err == nil && !errors.Is(e, ErrNotConnected) {
+			err = e
+		}
+	}
+	m.closeIdle()
+	return err
+}
+
+// ListSubscriptions ask the server the streams subscribed on every connection
+func (m *Manager) ListSubscriptions(ctx context.Context) ([]string, error) {
+	m.mu.RLock()
+	conns := append([]*conn(nil), m.conns...)
+	m.mu.RUnlock()
+	var streams []string
+	for _, c := range conns {
+		res, err := c.request(ctx, "LIST_SUBSCRIPTIONS", nil)
+		if err != nil {
+			return nil, err
+		}
+		var list []string
+		if err := json.Unmarshal(res, &list); err != nil {
+			return nil, err
+		}
+		streams = append(streams, list...)
+	}
+	sort.Strings(streams)
+	return streams, nil
+}
+
+// Streams return the subscribed streams sorted by name
+func (m *Manager) Streams() []string {
+	m.mu.RLock()
+	streams := make([]string, 0, len(m.owners))
+	for s := range m.owners {
+		streams = append(streams, s)
+	}
+	m.mu.RUnlock()
+	sort.Strings(streams)
+	return streams
+}
+
+// Connections return the number of open connections
+func (m *Manager) Connections() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return len(m.conns)
+}
+
+// Close close every connection and wait for them to stop
+func (m *Manager) Close() {
+	m.mu.Lock()
+	conns := m.conns
+	m.conns, m.owners, m.handlers = nil, nil, nil
+	m.closed = true
+	m.mu.Unlock()
+	for _, c := range conns {
+		c.stop()
+	}
+}
+
+// connWithRoom return the first connection below the stream limit
+func (m *Manager) connWithRoom() *conn {
+	max := m.MaxStreams
+	if max <= 0 {
+		max = defaultMaxStreams
+	}
+	for _, c := range m.conns {
+		if len(c.streams) < max {
+			return c
+		}
+	}
+	return nil
+}
+
+// forget remove streams from the manager and from their connection
+func (m *Manager) forget(streams []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, s := range streams {
+		if c, ok := m.owners[s]; ok {
+			delete(c.streams, s)
+			delete(m.owners, s)
+			delete(m.handlers, s)
+		}
+	}
+}
+
+// closeIdle close the connections without stream
+func (m *Manager) closeIdle() {
+	m.mu.Lock()
+	var idle []*conn
+	conns := m.conns[:0]
+	for _, c := range m.conns {
+		if len(c.streams) == 0 {
+			idle = append(idle, c)
+			continue
+		}
+		conns = append(conns, c)
+	}
+	m.conns = conns
+	m.mu.Unlock()
+	for _, c := range idle {
+		c.stop()
+	}
+}
+
+func (m *Manager) handler(stream string) Handler {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.handlers[stream]
+}
+
+func (m *Manager) handleErr(err error) {
+	if m.ErrHandler != nil {
+		m.ErrHandler(err)
+	}
+}
+
+func (m *Manager) dial() (*websocket.Conn, error) {
+	proxy := http.ProxyFromEnvironment
+	if m.Proxy != nil {
+		u, err := url.Parse(*m.Proxy)
+		if err != nil {
+			return nil, err
+		}
+		proxy = http.ProxyURL(u)
+	}
+	dialer := websocket.Dialer{
+		Proxy:            proxy,
+		HandshakeTimeout: 45 * time.Second,
+	}
+	ws, _, err := dialer.Dial(m.Endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	ws.SetReadLimit(655350)
+	return ws, nil
+}
+
+type batch struct {
+	c       *conn
+	streams []string
+}
+
+func addToBatch(batches []*batch, c *conn, stream string) []*batch {
+	for _, b := range batches {
+		if b.c == c {
+			b.streams = append(b.streams, stream)
+			return batches
+		}
+	}
+	return append(batches, &batch{c: c, streams: []string{stream}})
+}
+
+type request struct {
+	Method string   `json:"method"`
+	Params []string `json:"params,omitempty"`
+	ID     uint64   `json:"id"`
+}
+
+// message is either the data of a stream or the response to a request
+type message struct {
+	Stream string           `json:"stream"`
+	Data   json.RawMessage  `json:"data"`
+	ID     *uint64          `json:"id"`
+	Result json.RawMessage  `json:"result"`
+	Error  *common.APIError `json:"error"`
+	Code   int64            `json:"code"`
+	Msg    string           `json:"msg"`
+}
+
+type response struct {
+	result json.RawMessage
+	err    error
+}
+
+// conn is a combined stream connection, its streams are guarded by the mutex
+// of the manager
+type conn struct {
+	// lastID is first to be 64-bit aligned for atomic operations
+	lastID  uint64
+	m       *Manager
+	streams map[string]bool
+	limiter *common.RateLimiter
+
+	mu      sync.Mutex
+	ws      *websocket.Conn
+	pending map[uint64]chan *response
+	writeMu sync.Mutex
+
+	// readyC is closed once the first dial is done
+	readyC   chan struct{}
+	stopOnce sync.Once
+	stopC    chan struct{}
+	doneC    chan struct{}
+}
+
+func newConn(m *Manager) *conn {
+	rate := m.MessagesPerSecond
+	if rate <= 0 {
+		rate = defaultMessagesPerSecond
+	}
+	return &conn{
+		m:       m,
+		streams: make(map[string]bool),
+		limiter: common.NewRateLimiter(common.RateLimitRule{
+			Type:     common.RateLimitTypeRawRequests,
+			Interval: time.Second,
+			Limit:    rate,
+		}),
+		pending: make(map[uint64]chan *response),
+		readyC:  make(chan struct{}),
+		stopC:   make(chan struct{}),
+		doneC:   make(chan struct{}),
+	}
+}
+
+func (c *conn) connect() error {
+	ws, err := c.m.dial()
+	if err != nil {
+		c.abandon()
+		return err
+	}
+	c.mu.Lock()
+	c.ws = ws
+	c.mu.Unlock()
+	close(c.readyC)
+	go c.run(ws)
+	return nil
+}
+
+// abandon remove a connection which is not connected from the manager, its
+// requests fail with ErrNotConnected and stop returns at once
+func (c *conn) abandon() {
+	c.m.mu.Lock()
+	for i, o := range c.m.conns {
+		if o == c {
+			c.m.conns = append(c.m.conns[:i], c.m.conns[i+1:]...)
+			break
+		}
+	}
+	c.m.mu.Unlock()
+	close(c.doneC)
+	close(c.readyC)
+}
+
+func (c *conn) stop() {
+	c.stopOnce.Do(func() { close(c.stopC) })
+	<-c.doneC
+}
+
+// request send a request and wait for its response
+func (c *conn) request(ctx context.Context, method string, params []string) (json.RawMessage, error) {
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-c.readyC:
+	}
+	if err := c.limiter.Wait(ctx, common.RequestCost{}); err != nil {
+		return nil, err
+	}
+	id := atomic.AddUint64(&c.lastID, 1)
+	resC := make(chan *response, 1)
+	c.mu.Lock()
+	ws := c.ws
+	if ws == nil {
+		c.mu.Unlock()
+		return nil, ErrNotConnected
+	}
+	c.pending[id] = resC
+	c.mu.Unlock()
+
+	c.writeMu.Lock()
+	err := ws.WriteJSON(&request{Method: method, Params: params, ID: id})
+	c.writeMu.Unlock()
+	if err != nil {
+		c.removePending(id)
+		return nil, err
+	}
+	select {
+	case <-ctx.Done():
+		c.removePending(id)
+		return nil, ctx.Err()
+	case res := <-resC:
+		return res.result, res.err
+	}
+}
+
+func (c *conn) removePending(id uint64) {
+	c.mu.Lock()
+	delete(c.pending, id)
+	c.mu.Unlock()
+}
+
+// run read the connection, dialing it again when it is lost, until stop
+func (c *conn) run(ws *websocket.Conn) {
+	defer close(c.doneC)
+	for {
+		err := c.readLoop(ws)
+		c.mu.Lock()
+		c.ws = nil
+		for id, resC := range c.pending {
+			resC <- &response{err: ErrNotConnected}
+			delete(c.pending, id)
+		}
+		c.mu.Unlock()
+		if err == nil {
+			return
+		}
+		c.m.handleErr(err)
+		if ws = c.reconnect(); ws == nil {
+			return
+		}
+		go c.resubscribe()
+	}
+}
+
+// readLoop pass the messages of ws to the handlers until the connection fails
+// or stopC is closed, it returns nil when the connection was stopped
+func (c *conn) readLoop(ws *websocket.Conn) error {
+	var stopped int32
+	connDoneC := make(chan struct{})
+	defer close(connDoneC)
+	go func() {
+		select {
+		case <-c.stopC:
+			atomic.StoreInt32(&stopped, 1)
+		case <-connDoneC:
+		}
+		ws.Close()
+	}()
+	for {
+		_, data, err := ws.ReadMessage()
+		if err != nil {
+			if atomic.LoadInt32(&stopped) == 1 {
+				return nil
+			}
+			return err
+		}
+		msg := new(message)
+		if err := json.Unmarshal(data, msg); err != nil {
+			c.m.handleErr(err)
+			continue
+		}
+		if msg.ID != nil {
+			c.respond(msg)
+			continue
+		}
+		if handler := c.m.handler(msg.Stream); handler != nil {
+			handler(msg.Data)
+		}
+	}
+}
+
+func (c *conn) respond(msg *message) {
+	res := &response{result: msg.Result}
+	switch {
+	case msg.Error != nil:
+		res.err = msg.Error
+	case msg.Code != 0 || msg.Msg != "":
+		res.err = &common.APIError{Code: msg.Code, Message: msg.Msg}
+	}
+	c.mu.Lock()
+	resC, ok := c.pending[*msg.ID]
+	delete(c.pending, *msg.ID)
+	c.mu.Unlock()
+	if ok {
+		resC <- res
+	}
+}
+
+// reconnect dial the endpoint again, it returns nil if stopped while waiting
+func (c *conn) reconnect() *websocket.Conn {
+	backoff := c.m.Backoff
+	if backoff == nil {
+		backoff = common.DefaultBackoff
+	}
+	for attempt := 1; ; attempt++ {
+		timer := time.NewTimer(backoff.Backoff(attempt))
+		select {
+		case <-c.stopC:
+			timer.Stop()
+			return nil
+		case <-timer.C:
+		}
+		ws, err := c.m.dial()
+		if err != nil {
+			c.m.handleErr(err)
+			continue
+		}
+		c.mu.Lock()
+		c.ws = ws
+		c.mu.Unlock()
+		return ws
+	}
+}
+
+// resubscribe subscribe again to the streams of c after a reconnection
+func (c *conn) resubscribe() {
+	c.m.mu.RLock()
+	streams := make([]string, 0, len(c.streams))
+	for s := range c.streams {
+		streams = append(streams, s)
+	}
+	c.m.mu.RUnlock()
+	if len(streams) == 0 {
+		return
+	}
+	sort.Strings(streams)
+	ctx, cancel := context.WithTimeout(context.Background(), resubscribeTimeout)
+	defer cancel()
+	if _, err := c.request(ctx, "SUBSCRIBE", streams); err != nil {
+		c.m.handleErr(err)
+	}
+}
@@ -0,0 +1,256 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// fakeServer answers the requests of the combined streams and records the
+// streams subscribed on each connection
+type fakeServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	conns    []*fakeConn
+	requests []request
+	times    []time.Time
+}
+
+type fakeConn struct {
+	ws      *websocket.Conn
+	writeMu sync.Mutex
+	streams map[string]bool
+}
+
+func (c *fakeConn) write(v interface{}) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	c.ws.WriteJSON(v)
+}
+
+func newFakeServer() *fakeServer {
+	s := &fakeServer{}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		c := &fakeConn{ws: ws, streams: map[string]bool{}}
+		s.mu.Lock()
+		s.conns = append(s.conns, c)
+		s.mu.Unlock()
+		for {
+			var req request
+			if err := ws.ReadJSON(&req); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.requests = append(s.requests, req)
+			s.times = append(s.times, time.Now())
+			var result interface{}
+			switch req.Method {
+			case "SUBSCRIBE":
+				for _, p := range req.Params {
+					c.streams[p] = true
+				}
+			case "UNSUBSCRIBE":
+				for _, p := range req.Params {
+					delete(c.streams, p)
+				}
+			case "LIST_SUBSCRIPTIONS":
+				list := []string{}
+				for p := range c.streams {
+					list = append(list, p)
+				}
+				result = list
+			default:
+				s.mu.Unlock()
+				c.write(map[string]interface{}{"id": req.ID, "error": map[string]interface{}{"code": 2, "msg": "Invalid request"}})
+				continue
+			}
+			s.mu.Unlock()
+			c.write(map[string]interface{}{"id": req.ID, "result": result})
+		}
+	}))
+	return s
+}
+
+func (s *fakeServer) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+// push send data to the connections subscribed to stream
+func (s *fakeServer) push(stream string, data string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.conns {
+		if c.streams[stream] {
+			c.write(map[string]interface{}{"stream": stream, "data": json.RawMessage(data)})
+		}
+	}
+}
+
+func (s *fakeServer) subscribed() [][]string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var res [][]string
+	for _, c := range s.conns {
+		var streams []string
+		for p := range c.streams {
+			streams = append(streams, p)
+		}
+		sort.Strings(streams)
+		res = append(res, streams)
+	}
+	return res
+}
+
+type streamTestSuite struct {
+	suite.Suite
+	server  *fakeServer
+	manager *Manager
+}
+
+func TestStream(t *testing.T) {
+	suite.Run(t, new(streamTestSuite))
+}
+
+func (s *streamTestSuite) SetupTest() {
+	s.server = newFakeServer()
+	s.manager = NewManager(s.server.endpoint())
+	s.manager.MessagesPerSecond = 100
+	s.manager.Backoff = common.ConstantBackoff(10 * time.Millisecond)
+}
+
+func (s *streamTestSuite) TearDownTest() {
+	s.manager.Close()
+	s.server.Close()
+}
+
+type event struct {
+	Symbol string `json:"s"`
+	Price  string `json:"p"`
+}
+
+func (s *streamTestSuite) TestSubscribe() {
+	ctx := context.Background()
+	events := make(chan *event, 10)
+	handler := Decode(func(e *event) { events <- e }, nil)
+	s.Require().NoError(s.manager.Subscribe(ctx, handler, "btcusdt@aggTrade", "ethusdt@aggTrade"))
+	s.Equal([]string{"btcusdt@aggTrade", "ethusdt@aggTrade"}, s.manager.Streams())
+	s.Equal(1, s.manager.Connections())
+
+	s.server.push("ethusdt@aggTrade", `{"s": "ETHUSDT", "p": "2000"}`)
+	select {
+	case e := <-events:
+		s.Equal(&event{Symbol: "ETHUSDT", Price: "2000"}, e)
+	case <-time.After(time.Second):
+		s.Fail("no event")
+	}
+
+	list, err := s.manager.ListSubscriptions(ctx)
+	s.NoError(err)
+	s.Equal([]string{"btcusdt@aggTrade", "ethusdt@aggTrade"}, list)
+
+	s.NoError(s.manager.Unsubscribe(ctx, "btcusdt@aggTrade"))
+	s.Equal([][]string{{"ethusdt@aggTrade"}}, s.server.subscribed())
+	s.NoError(s.manager.Unsubscribe(ctx, "ethusdt@aggTrade"))
+	s.Equal(0, s.manager.Connections())
+}
+
+func (s *streamTestSuite) TestMaxStreams() {
+	s.manager.MaxStreams = 2
+	var streams []string
+	for i := 0; i < 5; i++ {
+		streams = append(streams, fmt.Sprintf("s%d@trade", i))
+	}
+	s.Require().NoError(s.manager.Subscribe(context.Background(), func(data []byte) {}, streams...))
+	s.Equal(3, s.manager.Connections())
+	s.Equal([][]string{{"s0@trade", "s1@trade"}, {"s2@trade", "s3@trade"}, {"s4@trade"}}, s.server.subscribed())
+}
+
+func (s *streamTestSuite) TestRateLimit() {
+	s.manager.MessagesPerSecond = 2
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		s.Require().NoError(s.manager.Subscribe(ctx, func(data []byte) {}, fmt.Sprintf("s%d@trade", i)))
+	}
+	s.Len(s.server.requests, 3)
+	perSecond := map[time.Time]int{}
+	for _, t := range s.server.times {
+		perSecond[t.Truncate(time.Second)]++
+	}
+	for _, n := range perSecond {
+		s.LessOrEqual(n, 2)
+	}
+}
+
+func (s *streamTestSuite) TestError() {
+	s.Require().NoError(s.manager.Subscribe(context.Background(), func(data []byte) {}, "btcusdt@trade"))
+	_, err := s.manager.conns[0].request(context.Background(), "UNKNOWN", nil)
+	apiErr, ok := err.(*common.APIError)
+	s.Require().True(ok)
+	s.Equal(int64(2), apiErr.Code)
+}
+
+func (s *streamTestSuite) TestReconnect() {
+	errC := make(chan error, 10)
+	s.manager.ErrHandler = func(err error) { errC <- err }
+	events := make(chan []byte, 10)
+	s.Require().NoError(s.manager.Subscribe(context.Background(), func(data []byte) { events <- data }, "btcusdt@trade"))
+
+	s.server.mu.Lock()
+	s.server.conns[0].ws.Close()
+	s.server.mu.Unlock()
+	select {
+	case <-errC:
+	case <-time.After(time.Second):
+		s.Fail("no disconnection")
+	}
+	s.Eventually(func() bool {
+		subscribed := s.server.subscribed()
+		return len(subscribed) == 2 && len(subscribed[1]) == 1
+	}, time.Second, 10*time.Millisecond)
+	s.server.push("btcusdt@trade", `{"s": "BTCUSDT"}`)
+	select {
+	case data := <-events:
+		s.JSONEq(`{"s": "BTCUSDT"}`, string(data))
+	case <-time.After(time.Second):
+		s.Fail("no event after reconnection")
+	}
+}
+
+func (s *streamTestSuite) TestDialFailure() {
+	s.server.Close()
+	s.manager.MaxStreams = 1
+	errC := make(chan error, 1)
+	go func() {
+		errC <- s.manager.Subscribe(context.Background(), func(data []byte) {}, "a@trade", "b@trade", "c@trade")
+	}()
+	select {
+	case err := <-errC:
+		s.Error(err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("subscribe blocked after a dial failure")
+	}
+	s.Equal(0, s.manager.Connections())
+	s.Empty(s.manager.Streams())
+}
+
+func (s *streamTestSuite) TestClosed() {
+	s.manager.Close()
+	s.Equal(ErrManagerClosed, s.manager.Subscribe(context.Background(), func(data []byte) {}, "btcusdt@trade"))
+}
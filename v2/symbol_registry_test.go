@@ -0,0 +1,122 @@
+package binance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type symbolRegistryTestSuite struct {
+	baseTestSuite
+}
+
+func TestSymbolRegistry(t *testing.T) {
+	suite.Run(t, new(symbolRegistryTestSuite))
+}
+
+func (s *symbolRegistryTestSuite) exchangeInfo(data string) *ExchangeInfo {
+	info := new(ExchangeInfo)
+	s.r().NoError(json.Unmarshal([]byte(data), info))
+	return info
+}
+
+func (s *symbolRegistryTestSuite) TestRefresh() {
+	data := []byte(`{
+		"symbols": [
+			{
+				"symbol": "ETHBTC",
+				"status": "TRADING",
+				"baseAsset": "ETH",
+				"quoteAsset": "BTC",
+				"baseAssetPrecision": 8,
+				"filters": [{"filterType": "PRICE_FILTER", "minPrice": "0.00001000", "maxPrice": "922327.00000000", "tickSize": "0.00001000"}]
+			}
+		]
+	}`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		s.assertRequestEqual(newRequest(), r)
+	})
+	registry := s.client.NewSymbolRegistry()
+	var events []*SymbolChangeEvent
+	registry.OnChange = func(event *SymbolChangeEvent) {
+		events = append(events, event)
+	}
+	_, loaded := registry.Loaded()
+	s.r().False(loaded)
+
+	s.r().NoError(registry.Refresh(newContext()))
+	_, loaded = registry.Loaded()
+	s.r().True(loaded)
+	s.r().Empty(events, "the first load does not emit events")
+	symbol, ok := registry.Symbol("ETHBTC")
+	s.r().True(ok)
+	s.r().Equal("ETH", symbol.BaseAsset)
+	s.r().Equal("0.00001000", symbol.PriceFilter().TickSize)
+	status, ok := registry.Status("ETHBTC")
+	s.r().True(ok)
+	s.r().Equal(SymbolStatusTypeTrading, status)
+	_, ok = registry.Symbol("BNBBTC")
+	s.r().False(ok)
+}
+
+func (s *symbolRegistryTestSuite) TestChangeEvents() {
+	registry := s.client.NewSymbolRegistry()
+	var events []*SymbolChangeEvent
+	registry.OnChange = func(event *SymbolChangeEvent) {
+		events = append(events, event)
+	}
+	registry.SetExchangeInfo(s.exchangeInfo(`{"symbols": [
+		{"symbol": "ETHBTC", "status": "TRADING", "filters": [{"filterType": "LOT_SIZE", "stepSize": "0.0001"}]},
+		{"symbol": "LTCBTC", "status": "TRADING"},
+		{"symbol": "XRPBTC", "status": "TRADING"}
+	]}`))
+	registry.SetExchangeInfo(s.exchangeInfo(`{"symbols": [
+		{"symbol": "BNBBTC", "status": "TRADING"},
+		{"symbol": "ETHBTC", "status": "HALT", "filters": [{"filterType": "LOT_SIZE", "stepSize": "0.001"}]},
+		{"symbol": "LTCBTC", "status": "TRADING"}
+	]}`))
+
+	s.r().Len(events, 4)
+	s.r().Equal(SymbolChangeTypeAdded, events[0].Type)
+	s.r().Equal("BNBBTC", events[0].Symbol)
+	s.r().Nil(events[0].Old)
+	s.r().Equal(SymbolChangeTypeStatus, events[1].Type)
+	s.r().Equal("ETHBTC", events[1].Symbol)
+	s.r().Equal("TRADING", events[1].Old.Status)
+	s.r().Equal(string(SymbolStatusTypeHalt), events[1].New.Status)
+	s.r().Equal(SymbolChangeTypeFilters, events[2].Type)
+	s.r().Equal("0.001", events[2].New.LotSizeFilter().StepSize)
+	s.r().Equal(SymbolChangeTypeRemoved, events[3].Type)
+	s.r().Equal("XRPBTC", events[3].Symbol)
+	s.r().Nil(events[3].New)
+
+	symbols := registry.Symbols()
+	s.r().Len(symbols, 3)
+	s.r().Equal("BNBBTC", symbols[0].Symbol)
+	s.r().Equal("LTCBTC", symbols[2].Symbol)
+}
+
+func (s *symbolRegistryTestSuite) TestStart() {
+	s.mockDo([]byte(`{"symbols": [{"symbol": "ETHBTC", "status": "TRADING"}]}`), nil)
+	registry := s.client.NewSymbolRegistry()
+	s.r().NoError(registry.Start(newContext()))
+	defer registry.Stop()
+	s.r().Error(registry.Start(newContext()))
+	_, ok := registry.Symbol("ETHBTC")
+	s.r().True(ok)
+}
+
+func (s *symbolRegistryTestSuite) TestOrderValidatorRegistry() {
+	registry := s.client.NewSymbolRegistry()
+	registry.SetExchangeInfo(s.exchangeInfo(`{"symbols": [
+		{"symbol": "ETHBTC", "status": "TRADING", "filters": [{"filterType": "LOT_SIZE", "minQty": "0.0001", "maxQty": "100000", "stepSize": "0.0001"}]}
+	]}`))
+	validator := s.client.NewOrderValidator()
+	validator.Registry = registry
+	order := s.client.NewCreateOrderService().Symbol("ETHBTC").Side(SideTypeBuy).Type(OrderTypeMarket)
+	s.r().NoError(validator.Validate(newContext(), order.Quantity("1")))
+	s.r().Error(validator.Validate(newContext(), order.Quantity("0.00001")))
+	s.r().Error(validator.Validate(newContext(), order.Symbol("LTCBTC")))
+}
@@ -3,6 +3,10 @@ package binance
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // ListTradesService list trades
@@ -87,6 +91,52 @@ func (s *ListTradesService) Do(ctx context.Context, opts ...RequestOption) (res
 	return res, nil
 }
 
+// Iterate walk the trades from fromID, or by windows of 24 hours from startTime
+// to endTime (default now), or from the first trade
+func (s *ListTradesService) Iterate(opts ...RequestOption) *common.Iterator[*TradeV3] {
+	limit := 1000
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	if s.orderId != nil {
+		return common.NewIterator(func(ctx context.Context) ([]*TradeV3, bool, error) {
+			trades, err := s.Do(ctx, opts...)
+			return trades, false, err
+		})
+	}
+	if s.startTime != nil && s.fromID == nil {
+		end := time.Now().UnixMilli()
+		if s.endTime != nil {
+			end = *s.endTime
+		}
+		return common.NewTimeIterator(*s.startTime, end, 24*time.Hour, limit,
+			func(ctx context.Context, start, end int64) ([]*TradeV3, error) {
+				page := *s
+				return page.StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+			},
+			func(t *TradeV3) int64 { return t.Time },
+			func(t *TradeV3) string { return strconv.FormatInt(t.ID, 10) })
+	}
+	var fromID int64
+	if s.fromID != nil {
+		fromID = *s.fromID
+	}
+	return common.NewIDIterator(fromID, limit, func(ctx context.Context, fromID int64) ([]*TradeV3, error) {
+		page := *s
+		page.startTime, page.endTime = nil, nil
+		trades, err := page.FromID(fromID).Limit(limit).Do(ctx, opts...)
+		if err != nil || s.endTime == nil {
+			return trades, err
+		}
+		for i, t := range trades {
+			if t.Time > *s.endTime {
+				return trades[:i], nil
+			}
+		}
+		return trades, nil
+	}, func(t *TradeV3) int64 { return t.ID })
+}
+
 // HistoricalTradesService trades
 type HistoricalTradesService struct {
 	c      *Client
@@ -241,6 +291,46 @@ func (s *AggTradesService) Do(ctx context.Context, opts ...RequestOption) (res [
 	return res, nil
 }
 
+// Iterate walk the aggregate trades from fromID, or by windows of 1 hour from
+// startTime to endTime (default now), or from the first trade
+func (s *AggTradesService) Iterate(opts ...RequestOption) *common.Iterator[*AggTrade] {
+	limit := 1000
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	if s.startTime != nil && s.fromID == nil {
+		end := time.Now().UnixMilli()
+		if s.endTime != nil {
+			end = *s.endTime
+		}
+		return common.NewTimeIterator(*s.startTime, end, time.Hour, limit,
+			func(ctx context.Context, start, end int64) ([]*AggTrade, error) {
+				page := *s
+				return page.StartTime(start).EndTime(end).Limit(limit).Do(ctx, opts...)
+			},
+			func(t *AggTrade) int64 { return t.Timestamp },
+			func(t *AggTrade) string { return strconv.FormatInt(t.AggTradeID, 10) })
+	}
+	var fromID int64
+	if s.fromID != nil {
+		fromID = *s.fromID
+	}
+	return common.NewIDIterator(fromID, limit, func(ctx context.Context, fromID int64) ([]*AggTrade, error) {
+		page := *s
+		page.startTime, page.endTime = nil, nil
+		trades, err := page.FromID(fromID).Limit(limit).Do(ctx, opts...)
+		if err != nil || s.endTime == nil {
+			return trades, err
+		}
+		for i, t := range trades {
+			if t.Timestamp > *s.endTime {
+				return trades[:i], nil
+			}
+		}
+		return trades, nil
+	}, func(t *AggTrade) int64 { return t.AggTradeID })
+}
+
 // AggTrade define aggregate trade info
 type AggTrade struct {
 	AggTradeID       int64  `json:"a"`
@@ -293,3 +383,211 @@ func (s *RecentTradesService) Do(ctx context.Context, opts ...RequestOption) (re
 	}
 	return res, nil
 }
+
+// ListPreventedMatchesService list the orders expired by self trade prevention
+type ListPreventedMatchesService struct {
+	c                    *Client
+	symbol               string
+	preventedMatchID     *int64
+	orderID              *int64
+	fromPreventedMatchID *int64
+	limit                *int
+}
+
+// Symbol set symbol
+func (s *ListPreventedMatchesService) Symbol(symbol string) *ListPreventedMatchesService {
+	s.symbol = symbol
+	return s
+}
+
+// PreventedMatchID set preventedMatchId
+func (s *ListPreventedMatchesService) PreventedMatchID(preventedMatchID int64) *ListPreventedMatchesService {
+	s.preventedMatchID = &preventedMatchID
+	return s
+}
+
+// OrderID set orderId
+func (s *ListPreventedMatchesService) OrderID(orderID int64) *ListPreventedMatchesService {
+	s.orderID = &orderID
+	return s
+}
+
+// FromPreventedMatchID set fromPreventedMatchId, it is used along with orderId
+func (s *ListPreventedMatchesService) FromPreventedMatchID(fromPreventedMatchID int64) *ListPreventedMatchesService {
+	s.fromPreventedMatchID = &fromPreventedMatchID
+	return s
+}
+
+// Limit set limit
+func (s *ListPreventedMatchesService) Limit(limit int) *ListPreventedMatchesService {
+	s.limit = &limit
+	return s
+}
+
+// Do send request
+func (s *ListPreventedMatchesService) Do(ctx context.Context, opts ...RequestOption) (res []*PreventedMatch, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/myPreventedMatches",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.preventedMatchID != nil {
+		r.setParam("preventedMatchId", *s.preventedMatchID)
+	}
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	if s.fromPreventedMatchID != nil {
+		r.setParam("fromPreventedMatchId", *s.fromPreventedMatchID)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*PreventedMatch{}, err
+	}
+	res = make([]*PreventedMatch, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*PreventedMatch{}, err
+	}
+	return res, nil
+}
+
+// Iterate walk the prevented matches of the order from fromPreventedMatchID
+func (s *ListPreventedMatchesService) Iterate(opts ...RequestOption) *common.Iterator[*PreventedMatch] {
+	limit := 500
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	if s.orderID == nil {
+		return common.NewIterator(func(ctx context.Context) ([]*PreventedMatch, bool, error) {
+			matches, err := s.Do(ctx, opts...)
+			return matches, false, err
+		})
+	}
+	var fromID int64
+	if s.fromPreventedMatchID != nil {
+		fromID = *s.fromPreventedMatchID
+	}
+	return common.NewIDIterator(fromID, limit, func(ctx context.Context, fromID int64) ([]*PreventedMatch, error) {
+		page := *s
+		return page.FromPreventedMatchID(fromID).Limit(limit).Do(ctx, opts...)
+	}, func(m *PreventedMatch) int64 { return m.PreventedMatchID })
+}
+
+// PreventedMatch define an order expired by self trade prevention
+type PreventedMatch struct {
+	Symbol                  string      `json:"symbol"`
+	PreventedMatchID        int64       `json:"preventedMatchId"`
+	TakerOrderID            int64       `json:"takerOrderId"`
+	MakerSymbol             string      `json:"makerSymbol"`
+	MakerOrderID            int64       `json:"makerOrderId"`
+	TradeGroupID            int64       `json:"tradeGroupId"`
+	SelfTradePreventionMode STPModeType `json:"selfTradePreventionMode"`
+	Price                   string      `json:"price"`
+	MakerPreventedQuantity  string      `json:"makerPreventedQuantity"`
+	TransactTime            int64       `json:"transactTime"`
+}
+
+// ListAllocationsService list the allocations of the orders placed through SOR
+type ListAllocationsService struct {
+	c                *Client
+	symbol           string
+	startTime        *int64
+	endTime          *int64
+	fromAllocationID *int64
+	limit            *int
+	orderID          *int64
+}
+
+// Symbol set symbol
+func (s *ListAllocationsService) Symbol(symbol string) *ListAllocationsService {
+	s.symbol = symbol
+	return s
+}
+
+// StartTime set startTime
+func (s *ListAllocationsService) StartTime(startTime int64) *ListAllocationsService {
+	s.startTime = &startTime
+	return s
+}
+
+// EndTime set endTime
+func (s *ListAllocationsService) EndTime(endTime int64) *ListAllocationsService {
+	s.endTime = &endTime
+	return s
+}
+
+// FromAllocationID set fromAllocationId
+func (s *ListAllocationsService) FromAllocationID(fromAllocationID int64) *ListAllocationsService {
+	s.fromAllocationID = &fromAllocationID
+	return s
+}
+
+// Limit set limit
+func (s *ListAllocationsService) Limit(limit int) *ListAllocationsService {
+	s.limit = &limit
+	return s
+}
+
+// OrderID set orderId
+func (s *ListAllocationsService) OrderID(orderID int64) *ListAllocationsService {
+	s.orderID = &orderID
+	return s
+}
+
+// Do send request
+func (s *ListAllocationsService) Do(ctx context.Context, opts ...RequestOption) (res []*Allocation, err error) {
+	r := &request{
+		method:   http.MethodGet,
+		endpoint: "/api/v3/myAllocations",
+		secType:  secTypeSigned,
+	}
+	r.setParam("symbol", s.symbol)
+	if s.startTime != nil {
+		r.setParam("startTime", *s.startTime)
+	}
+	if s.endTime != nil {
+		r.setParam("endTime", *s.endTime)
+	}
+	if s.fromAllocationID != nil {
+		r.setParam("fromAllocationId", *s.fromAllocationID)
+	}
+	if s.limit != nil {
+		r.setParam("limit", *s.limit)
+	}
+	if s.orderID != nil {
+		r.setParam("orderId", *s.orderID)
+	}
+	data, err := s.c.callAPI(ctx, r, opts...)
+	if err != nil {
+		return []*Allocation{}, err
+	}
+	res = make([]*Allocation, 0)
+	err = json.Unmarshal(data, &res)
+	if err != nil {
+		return []*Allocation{}, err
+	}
+	return res, nil
+}
+
+// Allocation define allocation info
+type Allocation struct {
+	Symbol          string `json:"symbol"`
+	AllocationID    int64  `json:"allocationId"`
+	AllocationType  string `json:"allocationType"`
+	OrderID         int64  `json:"orderId"`
+	OrderListID     int64  `json:"orderListId"`
+	Price           string `json:"price"`
+	Quantity        string `json:"qty"`
+	QuoteQuantity   string `json:"quoteQty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+	Time            int64  `json:"time"`
+	IsBuyer         bool   `json:"isBuyer"`
+	IsMaker         bool   `json:"isMaker"`
+	IsAllocator     bool   `json:"isAllocator"`
+}
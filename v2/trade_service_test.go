@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -233,3 +234,119 @@ func (s *tradeServiceTestSuite) assertTradeEqual(e, a *Trade) {
 	r.Equal(e.IsBuyerMaker, a.IsBuyerMaker, "IsBuyerMaker")
 	r.Equal(e.IsBestMatch, a.IsBestMatch, "IsBestMatch")
 }
+
+func (s *tradeServiceTestSuite) TestListPreventedMatches() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"preventedMatchId": 1,
+			"takerOrderId": 5,
+			"makerSymbol": "BTCUSDT",
+			"makerOrderId": 3,
+			"tradeGroupId": 1,
+			"selfTradePreventionMode": "EXPIRE_MAKER",
+			"price": "1.100000",
+			"makerPreventedQuantity": "1.300000",
+			"transactTime": 1669101687094
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":               "BTCUSDT",
+			"orderId":              int64(5),
+			"fromPreventedMatchId": int64(1),
+			"limit":                10,
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	matches, err := s.client.NewListPreventedMatchesService().Symbol("BTCUSDT").
+		OrderID(5).FromPreventedMatchID(1).Limit(10).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*PreventedMatch{{
+		Symbol:                  "BTCUSDT",
+		PreventedMatchID:        1,
+		TakerOrderID:            5,
+		MakerSymbol:             "BTCUSDT",
+		MakerOrderID:            3,
+		TradeGroupID:            1,
+		SelfTradePreventionMode: STPModeTypeExpireMaker,
+		Price:                   "1.100000",
+		MakerPreventedQuantity:  "1.300000",
+		TransactTime:            1669101687094,
+	}}, matches)
+}
+
+func (s *tradeServiceTestSuite) TestIteratePreventedMatches() {
+	var fromIDs []string
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		fromID := req.URL.Query().Get("fromPreventedMatchId")
+		fromIDs = append(fromIDs, fromID)
+		if fromID == "0" {
+			return newHTTPResponse([]byte(`[{"preventedMatchId": 1}, {"preventedMatchId": 2}]`), http.StatusOK), nil
+		}
+		return newHTTPResponse([]byte(`[{"preventedMatchId": 3}]`), http.StatusOK), nil
+	}
+
+	matches, err := s.client.NewListPreventedMatchesService().Symbol("BTCUSDT").OrderID(5).Limit(2).
+		Iterate().All(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Len(matches, 3)
+	r.Equal(int64(3), matches[2].PreventedMatchID)
+	r.Equal([]string{"0", "3"}, fromIDs)
+}
+
+func (s *tradeServiceTestSuite) TestListAllocations() {
+	data := []byte(`[
+		{
+			"symbol": "BTCUSDT",
+			"allocationId": 0,
+			"allocationType": "SOR",
+			"orderId": 1,
+			"orderListId": -1,
+			"price": "1.00000000",
+			"qty": "5.00000000",
+			"quoteQty": "5.00000000",
+			"commission": "0.00000000",
+			"commissionAsset": "BTC",
+			"time": 1687506878118,
+			"isBuyer": true,
+			"isMaker": false,
+			"isAllocator": false
+		}
+	]`)
+	s.mockDo(data, nil)
+	defer s.assertDo()
+	s.assertReq(func(r *request) {
+		e := newSignedRequest().setParams(params{
+			"symbol":    "BTCUSDT",
+			"startTime": int64(1687506878000),
+			"endTime":   int64(1687506879000),
+			"limit":     10,
+			"orderId":   int64(1),
+		})
+		s.assertRequestEqual(e, r)
+	})
+
+	allocations, err := s.client.NewListAllocationsService().Symbol("BTCUSDT").
+		StartTime(1687506878000).EndTime(1687506879000).Limit(10).OrderID(1).Do(newContext())
+	r := s.r()
+	r.NoError(err)
+	r.Equal([]*Allocation{{
+		Symbol:          "BTCUSDT",
+		AllocationType:  "SOR",
+		OrderID:         1,
+		OrderListID:     -1,
+		Price:           "1.00000000",
+		Quantity:        "5.00000000",
+		QuoteQuantity:   "5.00000000",
+		Commission:      "0.00000000",
+		CommissionAsset: "BTC",
+		Time:            1687506878118,
+		IsBuyer:         true,
+	}}, allocations)
+}
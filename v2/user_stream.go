@@ -0,0 +1,71 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// UserStream define a managed user data stream, it keeps the listen key alive
+// and reconnects with a new listen key when it expires or the connection is lost
+type UserStream = common.UserStream[*WsUserDataEvent]
+
+func newUserStream(start func(ctx context.Context) (string, error), keepalive, close func(ctx context.Context, listenKey string) error) *UserStream {
+	return common.NewUserStream(common.UserStreamFuncs[*WsUserDataEvent]{
+		Start:     start,
+		Keepalive: keepalive,
+		Close:     close,
+		Serve: func(listenKey string, handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+			// WebsocketReconnect is left out, a dropped connection must end doneC
+			// so that the stream reconnects with a listen key and reports the gap
+			cfg := &WsConfig{Endpoint: fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey), Proxy: getWsProxyUrl()}
+			return wsUserDataServe(cfg, handler, errHandler)
+		},
+		Expired: func(event *WsUserDataEvent) bool {
+			return event.Event == UserDataEventTypeListenKeyExpired
+		},
+	})
+}
+
+// NewUserStream init a managed user data stream of the spot account
+func (c *Client) NewUserStream() *UserStream {
+	return newUserStream(
+		func(ctx context.Context) (string, error) {
+			return c.NewStartUserStreamService().Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewCloseUserStreamService().ListenKey(listenKey).Do(ctx)
+		})
+}
+
+// NewMarginUserStream init a managed user data stream of the cross margin account
+func (c *Client) NewMarginUserStream() *UserStream {
+	return newUserStream(
+		func(ctx context.Context) (string, error) {
+			return c.NewStartMarginUserStreamService().Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewCloseMarginUserStreamService().ListenKey(listenKey).Do(ctx)
+		})
+}
+
+// NewIsolatedMarginUserStream init a managed user data stream of the isolated margin account of symbol
+func (c *Client) NewIsolatedMarginUserStream(symbol string) *UserStream {
+	return newUserStream(
+		func(ctx context.Context) (string, error) {
+			return c.NewStartIsolatedMarginUserStreamService().Symbol(symbol).Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewKeepaliveIsolatedMarginUserStreamService().Symbol(symbol).ListenKey(listenKey).Do(ctx)
+		},
+		func(ctx context.Context, listenKey string) error {
+			return c.NewCloseIsolatedMarginUserStreamService().Symbol(symbol).ListenKey(listenKey).Do(ctx)
+		})
+}
@@ -0,0 +1,140 @@
+package binance
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type userStreamTestSuite struct {
+	baseTestSuite
+	origWsServe func(*WsConfig, WsHandler, ErrHandler) (chan struct{}, chan struct{}, error)
+}
+
+func TestUserStream(t *testing.T) {
+	suite.Run(t, new(userStreamTestSuite))
+}
+
+func (s *userStreamTestSuite) SetupTest() {
+	s.baseTestSuite.SetupTest()
+	s.origWsServe = wsServe
+}
+
+func (s *userStreamTestSuite) TearDownTest() {
+	wsServe = s.origWsServe
+}
+
+func (s *userStreamTestSuite) TestExpired() {
+	var mu sync.Mutex
+	var requests []string
+	keys := 0
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		var form url.Values
+		if req.Body != nil {
+			body, _ := ioutil.ReadAll(req.Body)
+			form, _ = url.ParseQuery(string(body))
+		}
+		requests = append(requests, req.Method+" "+form.Get("listenKey"))
+		if req.Method == http.MethodPost {
+			keys++
+			return newHTTPResponse([]byte(fmt.Sprintf(`{"listenKey": "key%d"}`, keys)), http.StatusOK), nil
+		}
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+
+	handlers := make(chan WsHandler, 2)
+	endpoints := make(chan string, 2)
+	wsServe = func(cfg *WsConfig, handler WsHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
+		doneC, stopC = make(chan struct{}), make(chan struct{})
+		go func() {
+			<-stopC
+			close(doneC)
+		}()
+		endpoints <- cfg.Endpoint
+		handlers <- handler
+		return doneC, stopC, nil
+	}
+
+	stream := s.client.NewUserStream()
+	stream.Backoff = common.ConstantBackoff(time.Millisecond)
+	events := make(chan *WsUserDataEvent, 2)
+	stream.Handler = func(event *WsUserDataEvent) { events <- event }
+	gaps := make(chan common.UserStreamGap, 1)
+	stream.OnGap = func(gap common.UserStreamGap) { gaps <- gap }
+	s.r().NoError(stream.Start(newContext()))
+	s.True(strings.HasSuffix(<-endpoints, "/key1"))
+
+	handler := <-handlers
+	handler([]byte(`{"e": "listenKeyExpired", "E": 1576653824250, "listenKey": "key1"}`))
+	s.Equal(UserDataEventTypeListenKeyExpired, (<-events).Event)
+	select {
+	case gap := <-gaps:
+		s.Equal(common.ErrUserStreamExpired, gap.Err)
+	case <-time.After(time.Second):
+		s.FailNow("no gap")
+	}
+	s.True(strings.HasSuffix(<-endpoints, "/key2"))
+	s.Equal("key2", stream.ListenKey())
+
+	stream.Stop()
+	mu.Lock()
+	defer mu.Unlock()
+	s.Equal([]string{"POST ", "POST ", "DELETE key2"}, requests)
+}
+
+func (s *userStreamTestSuite) TestDisconnectedWithWebsocketReconnect() {
+	server, endpoint := newDroppingWsServer(s.T())
+	defer server.Close()
+	origURL, origReconnect := BaseWsMainURL, WebsocketReconnect
+	defer func() { BaseWsMainURL, WebsocketReconnect = origURL, origReconnect }()
+	BaseWsMainURL = endpoint
+	WebsocketReconnect = &WsReconnectConfig{Backoff: common.ConstantBackoff(time.Millisecond)}
+
+	var mu sync.Mutex
+	keys := 0
+	s.client.Client.do = func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if req.Method == http.MethodPost {
+			keys++
+			return newHTTPResponse([]byte(fmt.Sprintf(`{"listenKey": "key%d"}`, keys)), http.StatusOK), nil
+		}
+		return newHTTPResponse([]byte(`{}`), http.StatusOK), nil
+	}
+
+	stream := s.client.NewUserStream()
+	stream.Backoff = common.ConstantBackoff(time.Millisecond)
+	gaps := make(chan common.UserStreamGap, 10)
+	stream.OnGap = func(gap common.UserStreamGap) { gaps <- gap }
+	s.r().NoError(stream.Start(newContext()))
+	defer stream.Stop()
+	select {
+	case gap := <-gaps:
+		s.Error(gap.Err)
+	case <-time.After(5 * time.Second):
+		s.FailNow("no gap after the connection was dropped")
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	s.GreaterOrEqual(keys, 2)
+}
+
+func (s *userStreamTestSuite) TestStartError() {
+	s.mockDo([]byte(`{"code": -2015, "msg": "Invalid API-key, IP, or permissions for action."}`), nil, http.StatusUnauthorized)
+	stream := s.client.NewMarginUserStream()
+	err := stream.Start(context.Background())
+	s.r().Error(err)
+	s.True(common.IsAPIError(err))
+}
@@ -0,0 +1,151 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// WsDepthServeChan serve the diff depth stream of symbol like WsDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsDepthServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsDepthServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsKlineServeChan serve the kline stream of symbol like WsKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsKlineServeChan(ctx context.Context, symbol string, interval string, opts ...common.ChanOption) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsKlineServe(symbol, interval, handler, errHandler)
+	}, opts...)
+}
+
+// WsAggTradeServeChan serve the aggregate trade stream of symbol like WsAggTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAggTradeServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsAggTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsAggTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAggTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsTradeServeChan serve the trade stream of symbol like WsTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsTradeServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsTradeServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsBookTickerServeChan serve the book ticker stream of symbol like WsBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsBookTickerServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsBookTickerServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsUserDataServeChan serve the user data stream of listenKey like WsUserDataServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsUserDataServeChan(ctx context.Context, listenKey string, opts ...common.ChanOption) (<-chan *WsUserDataEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsUserDataEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsUserDataServe(listenKey, handler, errHandler)
+	}, opts...)
+}
+
+// WsPartialDepthServeChan serve the partial depth stream of symbol like WsPartialDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsPartialDepthServeChan(ctx context.Context, symbol string, levels string, opts ...common.ChanOption) (<-chan *WsPartialDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsPartialDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsPartialDepthServe(symbol, levels, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedPartialDepthServeChan serve the partial depth streams of symbolLevels like WsCombinedPartialDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedPartialDepthServeChan(ctx context.Context, symbolLevels map[string]string, opts ...common.ChanOption) (<-chan *WsPartialDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsPartialDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedPartialDepthServe(symbolLevels, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedDepthServeChan serve the depth streams of symbols like WsCombinedDepthServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedDepthServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsDepthEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsDepthEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedDepthServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedKlineServeChan serve the kline streams of symbolIntervalPair like WsCombinedKlineServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedKlineServeChan(ctx context.Context, symbolIntervalPair map[string]string, opts ...common.ChanOption) (<-chan *WsKlineEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsKlineEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedKlineServe(symbolIntervalPair, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedAggTradeServeChan serve the aggregate trade streams of symbols like WsCombinedAggTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedAggTradeServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsAggTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsAggTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedAggTradeServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedTradeServeChan serve the trade streams of symbols like WsCombinedTradeServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedTradeServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsCombinedTradeEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsCombinedTradeEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedTradeServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedMarketStatServeChan serve the market stat streams of symbols like WsCombinedMarketStatServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedMarketStatServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsMarketStatEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarketStatEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedMarketStatServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsMarketStatServeChan serve the market stat stream of symbol like WsMarketStatServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsMarketStatServeChan(ctx context.Context, symbol string, opts ...common.ChanOption) (<-chan *WsMarketStatEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsMarketStatEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsMarketStatServe(symbol, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllMarketsStatServeChan serve the all markets stat stream like WsAllMarketsStatServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllMarketsStatServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsAllMarketsStatEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsAllMarketsStatEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllMarketsStatServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsAllMiniMarketsStatServeChan serve the all mini markets stat stream like WsAllMiniMarketsStatServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllMiniMarketsStatServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan WsAllMiniMarketsStatEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event WsAllMiniMarketsStatEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllMiniMarketsStatServe(handler, errHandler)
+	}, opts...)
+}
+
+// WsCombinedBookTickerServeChan serve the book ticker streams of symbols like WsCombinedBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsCombinedBookTickerServeChan(ctx context.Context, symbols []string, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsCombinedBookTickerServe(symbols, handler, errHandler)
+	}, opts...)
+}
+
+// WsAllBookTickerServeChan serve the all book ticker stream like WsAllBookTickerServe, the events and errors are returned
+// through channels and the stream is stopped when ctx is done
+func WsAllBookTickerServeChan(ctx context.Context, opts ...common.ChanOption) (<-chan *WsBookTickerEvent, <-chan error, error) {
+	return common.ServeChan(ctx, func(handler func(event *WsBookTickerEvent), errHandler func(err error)) (doneC, stopC chan struct{}, err error) {
+		return WsAllBookTickerServe(handler, errHandler)
+	}, opts...)
+}
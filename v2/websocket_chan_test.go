@@ -0,0 +1,57 @@
+package binance
+
+import (
+	"context"
+	"errors"
+)
+
+func (s *websocketServiceTestSuite) TestDepthServeChan() {
+	s.mockWsServe([]byte(`{
+		"e": "depthUpdate",
+		"E": 1499404630606,
+		"s": "ETHBTC",
+		"U": 7913452,
+		"u": 7913455,
+		"b": [["0.10376590", "59.15767010", []]],
+		"a": [["0.10376586", "159.15767010", []]]
+	}`), errors.New("fake error"))
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, errs, err := WsDepthServeChan(ctx, "ETHBTC")
+	s.r().NoError(err)
+	event := <-events
+	s.Equal("ETHBTC", event.Symbol)
+	s.Equal(int64(7913455), event.LastUpdateID)
+	s.EqualError(<-errs, "fake error")
+	cancel()
+	_, ok := <-events
+	s.False(ok)
+	_, ok = <-errs
+	s.False(ok)
+}
+
+func (s *websocketServiceTestSuite) TestCombinedBookTickerServeChan() {
+	s.mockWsServe([]byte(`{
+		"stream": "bnbusdt@bookTicker",
+		"data": {
+			"u": 400900217,
+			"s": "BNBUSDT",
+			"b": "25.35190000",
+			"B": "31.21000000",
+			"a": "25.36520000",
+			"A": "40.66000000"
+		}
+	}`), nil)
+	defer s.assertWsServe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, _, err := WsCombinedBookTickerServeChan(ctx, []string{"BNBUSDT", "BTCUSDT"})
+	s.r().NoError(err)
+	event := <-events
+	s.Equal("BNBUSDT", event.Symbol)
+	s.Equal(int64(400900217), event.UpdateID)
+	cancel()
+	_, ok := <-events
+	s.False(ok)
+}
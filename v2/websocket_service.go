@@ -18,6 +18,8 @@ var (
 	WebsocketTimeout = time.Second * 60
 	// WebsocketKeepalive enables sending ping/pong messages to check the connection stability
 	WebsocketKeepalive = false
+	// WebsocketReconnect enables reconnecting dropped streams with the given policy, nil disables it
+	WebsocketReconnect *WsReconnectConfig
 	ProxyUrl           = ""
 )
 
@@ -581,6 +583,7 @@ type WsOrderUpdate struct {
 	UsedSor                    bool   `json:"uS"` // Appears for orders that used SOR
 }
 
+// WsOCOUpdate define the update of an order list, ContingencyType is OCO or OTO (for OTO and OTOCO lists)
 type WsOCOUpdate struct {
 	Symbol          string `json:"s"`
 	OrderListId     int64  `json:"g"`
@@ -608,8 +611,11 @@ type WsUserDataHandler func(event *WsUserDataEvent)
 
 // WsUserDataServe serve user data handler with listen key
 func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
-	endpoint := fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)
-	cfg := newWsConfig(endpoint)
+	return wsUserDataServe(newWsConfig(fmt.Sprintf("%s/%s", getWsEndpoint(), listenKey)), handler, errHandler)
+}
+
+// wsUserDataServe serve the user data stream with cfg
+func wsUserDataServe(cfg *WsConfig, handler WsUserDataHandler, errHandler ErrHandler) (doneC, stopC chan struct{}, err error) {
 	wsHandler := func(message []byte) {
 		j, err := newJSON(message)
 		if err != nil {
@@ -650,6 +656,11 @@ func WsUserDataServe(listenKey string, handler WsUserDataHandler, errHandler Err
 				errHandler(err)
 				return
 			}
+			err = json.Unmarshal(message, &event.OCOUpdate.Orders)
+			if err != nil {
+				errHandler(err)
+				return
+			}
 		}
 
 		handler(event)
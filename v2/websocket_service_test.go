@@ -1133,6 +1133,135 @@ func (s *websocketServiceTestSuite) TestWsUserDataServeOrderUpdateWithTradeOrder
 	s.testWsUserDataServe(data, expectedEvent)
 }
 
+func (s *websocketServiceTestSuite) TestWsUserDataServeOrderUpdateWithSOROrder() {
+	data := []byte(`{
+          "e":  "executionReport",
+          "E":  1689149087774,
+          "s":  "BTCUSDT",
+          "c":  "sor-order-1",
+          "S":  "BUY",
+          "o":  "LIMIT",
+          "f":  "GTC",
+          "q":  "0.50000000",
+          "p":  "31000.00000000",
+          "P":  "0.00000000",
+          "F":  "0.00000000",
+          "g":  -1,
+          "C":  "",
+          "x":  "TRADE",
+          "X":  "PARTIALLY_FILLED",
+          "r":  "NONE",
+          "i":  2,
+          "l":  "0.10000000",
+          "z":  "0.10000000",
+          "L":  "30000.00000000",
+          "n":  "0.00000000",
+          "N":  "BTC",
+          "T":  1689149087774,
+          "t":  -1,
+          "I":  6,
+          "w":  true,
+          "m":  false,
+          "M":  true,
+          "O":  1689149087774,
+          "Z":  "3000.00000000",
+          "Y":  "3000.00000000",
+          "Q":  "0.00000000",
+          "W":  1689149087774,
+          "V":  "EXPIRE_TAKER",
+          "b":  "ONE_PARTY_TRADE_REPORT",
+          "a":  1234,
+          "k":  "SOR",
+          "uS": true
+	}`)
+	expectedEvent := &WsUserDataEvent{
+		Event: "executionReport",
+		Time:  1689149087774,
+		OrderUpdate: WsOrderUpdate{
+			Symbol:                  "BTCUSDT",
+			ClientOrderId:           "sor-order-1",
+			Side:                    "BUY",
+			Type:                    "LIMIT",
+			TimeInForce:             "GTC",
+			Volume:                  "0.50000000",
+			Price:                   "31000.00000000",
+			StopPrice:               "0.00000000",
+			IceBergVolume:           "0.00000000",
+			OrderListId:             -1,
+			ExecutionType:           "TRADE",
+			Status:                  "PARTIALLY_FILLED",
+			RejectReason:            "NONE",
+			Id:                      2,
+			LatestVolume:            "0.10000000",
+			FilledVolume:            "0.10000000",
+			LatestPrice:             "30000.00000000",
+			FeeAsset:                "BTC",
+			FeeCost:                 "0.00000000",
+			TransactionTime:         1689149087774,
+			TradeId:                 -1,
+			IgnoreI:                 6,
+			IsInOrderBook:           true,
+			IgnoreM:                 true,
+			CreateTime:              1689149087774,
+			FilledQuoteVolume:       "3000.00000000",
+			LatestQuoteVolume:       "3000.00000000",
+			QuoteVolume:             "0.00000000",
+			WorkingTime:             1689149087774,
+			SelfTradePreventionMode: "EXPIRE_TAKER",
+			MatchType:               "ONE_PARTY_TRADE_REPORT",
+			AllocationId:            1234,
+			WorkingFloor:            "SOR",
+			UsedSor:                 true,
+		},
+	}
+	s.testWsUserDataServe(data, expectedEvent)
+}
+
+func (s *websocketServiceTestSuite) TestWsUserDataServeListStatus() {
+	data := []byte(`{
+		"e": "listStatus",
+		"E": 1564035303637,
+		"s": "ETHBTC",
+		"g": 2,
+		"c": "OTO",
+		"l": "EXEC_STARTED",
+		"L": "EXECUTING",
+		"r": "NONE",
+		"C": "F4QN4G8DlFATFlIUQ0cjdD",
+		"T": 1564035303625,
+		"O": [
+			{"s": "ETHBTC", "i": 17, "c": "AJYsMjErWJesZvqlJCTUgL"},
+			{"s": "ETHBTC", "i": 18, "c": "bfYPSQdLoqAJeNrOr9adzq"}
+		]
+	}`)
+	s.mockWsServe(data, nil)
+	defer s.assertWsServe()
+
+	var event *WsUserDataEvent
+	doneC, stopC, err := WsUserDataServe("fakeListenKey", func(e *WsUserDataEvent) {
+		event = e
+	}, func(err error) {})
+	s.r().NoError(err)
+	stopC <- struct{}{}
+	<-doneC
+	s.r().NotNil(event)
+	s.Equal(UserDataEventTypeListStatus, event.Event)
+	s.Equal(WsOCOUpdate{
+		Symbol:          "ETHBTC",
+		OrderListId:     2,
+		ContingencyType: string(ContingencyTypeOTO),
+		ListStatusType:  string(ListStatusTypeExecStarted),
+		ListOrderStatus: string(ListOrderStatusTypeExecuting),
+		RejectReason:    "NONE",
+		ClientOrderId:   "F4QN4G8DlFATFlIUQ0cjdD",
+		TransactionTime: 1564035303625,
+		Orders: WsOCOOrderList{WsOCOOrders: []WsOCOOrder{
+			{Symbol: "ETHBTC", OrderId: 17, ClientOrderId: "AJYsMjErWJesZvqlJCTUgL"},
+			{Symbol: "ETHBTC", OrderId: 18, ClientOrderId: "bfYPSQdLoqAJeNrOr9adzq"},
+		}},
+	}, event.OCOUpdate)
+}
+
 func (s *websocketServiceTestSuite) TestWsMarketStatServe() {
 	data := []byte(`{
   		"e": "24hrTicker",
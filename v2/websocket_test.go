@@ -0,0 +1,145 @@
+package binance
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// newDroppingWsServer starts a websocket server which sends the number of the
+// connection as a single message and then drops the connection.
+func newDroppingWsServer(t *testing.T) (*httptest.Server, string) {
+	var mu sync.Mutex
+	conns := 0
+	upgrader := websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		c, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer c.Close()
+		mu.Lock()
+		conns++
+		n := conns
+		mu.Unlock()
+		c.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf("%d", n)))
+	}))
+	return server, "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestWsServeWithoutReconnect(t *testing.T) {
+	server, endpoint := newDroppingWsServer(t)
+	defer server.Close()
+
+	messages := make(chan string, 10)
+	errC := make(chan error, 1)
+	cfg := &WsConfig{Endpoint: endpoint}
+	doneC, _, err := wsServe(cfg, func(message []byte) {
+		messages <- string(message)
+	}, func(err error) {
+		errC <- err
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream was not closed")
+	}
+	require.Equal(t, "1", <-messages)
+	require.Error(t, <-errC)
+}
+
+func TestWsServeWithReconnect(t *testing.T) {
+	server, endpoint := newDroppingWsServer(t)
+	defer server.Close()
+
+	var mu sync.Mutex
+	var disconnected, reconnecting, reconnected int
+	cfg := &WsConfig{
+		Endpoint: endpoint,
+		Reconnect: &WsReconnectConfig{
+			Backoff: common.ConstantBackoff(10 * time.Millisecond),
+			OnDisconnected: func(e string, err error) {
+				require.Equal(t, endpoint, e)
+				require.Error(t, err)
+				mu.Lock()
+				disconnected++
+				mu.Unlock()
+			},
+			OnReconnecting: func(e string, attempt int, delay time.Duration) {
+				require.Equal(t, 1, attempt)
+				require.Equal(t, 10*time.Millisecond, delay)
+				mu.Lock()
+				reconnecting++
+				mu.Unlock()
+			},
+			OnReconnected: func(e string) {
+				mu.Lock()
+				reconnected++
+				mu.Unlock()
+			},
+		},
+	}
+	messages := make(chan string, 10)
+	doneC, stopC, err := wsServe(cfg, func(message []byte) {
+		messages <- string(message)
+	}, func(err error) {
+		t.Errorf("unexpected error: %v", err)
+	})
+	require.NoError(t, err)
+
+	for _, e := range []string{"1", "2", "3"} {
+		select {
+		case m := <-messages:
+			require.Equal(t, e, m)
+		case <-time.After(5 * time.Second):
+			t.Fatal("stream was not reconnected")
+		}
+	}
+	close(stopC)
+	<-doneC
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.True(t, disconnected >= 2)
+	require.True(t, reconnecting >= 2)
+	require.True(t, reconnected >= 2)
+}
+
+func TestWsServeReconnectGivesUp(t *testing.T) {
+	server, endpoint := newDroppingWsServer(t)
+
+	cfg := &WsConfig{
+		Endpoint: endpoint,
+		Reconnect: &WsReconnectConfig{
+			Backoff:     common.ConstantBackoff(10 * time.Millisecond),
+			MaxAttempts: 2,
+			OnDisconnected: func(e string, err error) {
+				server.Close()
+			},
+		},
+	}
+	errC := make(chan error, 1)
+	doneC, _, err := wsServe(cfg, func(message []byte) {}, func(err error) {
+		errC <- err
+	})
+	require.NoError(t, err)
+
+	select {
+	case <-doneC:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream was not closed")
+	}
+	require.Error(t, <-errC)
+}
@@ -3,6 +3,9 @@ package binance
 import (
 	"context"
 	"net/http"
+	"time"
+
+	"github.com/adshao/go-binance/v2/common"
 )
 
 // CreateWithdrawService submits a withdraw request.
@@ -210,6 +213,30 @@ func (s *ListWithdrawsService) Do(ctx context.Context) (res []*Withdraw, err err
 	return res, nil
 }
 
+// Iterate walk the withdraws by windows of 90 days from startTime to endTime
+// (default now), or the withdraws returned without time range
+func (s *ListWithdrawsService) Iterate() *common.Iterator[*Withdraw] {
+	limit := 1000
+	if s.limit != nil {
+		limit = *s.limit
+	}
+	var start, end int64
+	if s.startTime != nil {
+		start, end = *s.startTime, time.Now().UnixMilli()
+		if s.endTime != nil {
+			end = *s.endTime
+		}
+	}
+	return common.NewOffsetIterator(start, end, 90*24*time.Hour, limit,
+		func(ctx context.Context, start, end int64, offset int) ([]*Withdraw, error) {
+			page := *s
+			if end > 0 {
+				page.StartTime(start).EndTime(end)
+			}
+			return page.Offset(offset).Limit(limit).Do(ctx)
+		})
+}
+
 // Withdraw represents a single withdraw entry.
 type Withdraw struct {
 	Address         string `json:"address"`
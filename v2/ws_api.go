@@ -0,0 +1,368 @@
+package binance
+
+import (
+	"context"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+// Endpoints
+var (
+	BaseWsAPIMainURL    = "wss://ws-api.binance.com:443/ws-api/v3"
+	BaseWsAPITestnetURL = "wss://testnet.binance.vision/ws-api/v3"
+)
+
+// ErrWsAPINotConnected is returned when a request is sent before Connect or after the connection is lost
+var ErrWsAPINotConnected = common.ErrWsAPINotConnected
+
+// getWsAPIEndpoint return the base endpoint of the WS API according the UseTestnet flag
+func getWsAPIEndpoint() string {
+	if UseTestnet {
+		return BaseWsAPITestnetURL
+	}
+	return BaseWsAPIMainURL
+}
+
+// WsAPIClient define a client of the websocket API, requests are sent over one
+// persistent connection and matched to their responses by id.
+// Services will be created by the form client.NewXXXService().
+type WsAPIClient struct {
+	*common.WsAPIConn[WsAPIRateLimit]
+}
+
+// WsAPIResponse define a response of the websocket API
+type WsAPIResponse = common.WsAPIResponse[WsAPIRateLimit]
+
+// WsAPIRateLimit define the usage of a rate limit returned with a websocket API response
+type WsAPIRateLimit struct {
+	RateLimitType RateLimitType     `json:"rateLimitType"`
+	Interval      RateLimitInterval `json:"interval"`
+	IntervalNum   int64             `json:"intervalNum"`
+	Limit         int64             `json:"limit"`
+	Count         int64             `json:"count"`
+}
+
+// WsAPISessionStatus define the status of an authenticated websocket API session
+type WsAPISessionStatus = common.WsAPISessionStatus
+
+// NewWsAPIClient initialize a websocket API client instance with API key and secret key.
+// Call Connect before sending requests.
+func NewWsAPIClient(apiKey, secretKey string) *WsAPIClient {
+	return &WsAPIClient{common.NewWsAPIConn[WsAPIRateLimit](apiKey, secretKey, getWsAPIEndpoint(), wsAPIDial)}
+}
+
+func wsAPIDial(endpoint string) (*websocket.Conn, error) {
+	return wsDial(newWsConfig(endpoint))
+}
+
+// Call send a request with the given method and parameters and wait for its response.
+// Signed requests are authenticated with the session if SessionLogon succeeded,
+// otherwise they carry the API key and a signature.
+func (c *WsAPIClient) Call(ctx context.Context, method string, parameters map[string]interface{}, signed bool, opts ...RequestOption) (*WsAPIResponse, error) {
+	st := secTypeNone
+	if signed {
+		st = secTypeSigned
+	}
+	return c.call(ctx, method, parameters, st, opts...)
+}
+
+func (c *WsAPIClient) call(ctx context.Context, method string, m params, st secType, opts ...RequestOption) (*WsAPIResponse, error) {
+	r := newWsAPIRequest(st, opts...)
+	return c.Send(ctx, method, m, wsAPISecType(st), r.recvWindow)
+}
+
+func (c *WsAPIClient) callAPI(ctx context.Context, method string, m params, st secType, v interface{}, opts ...RequestOption) error {
+	res, err := c.call(ctx, method, m, st, opts...)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(res.Result, v)
+}
+
+func newWsAPIRequest(st secType, opts ...RequestOption) *request {
+	r := &request{secType: st}
+	for _, opt := range opts {
+		opt(r)
+	}
+	return r
+}
+
+func wsAPISecType(st secType) common.WsAPISecType {
+	switch st {
+	case secTypeAPIKey:
+		return common.WsAPISecTypeAPIKey
+	case secTypeSigned:
+		return common.WsAPISecTypeSigned
+	}
+	return common.WsAPISecTypeNone
+}
+
+// SessionLogon authenticate the connection with an Ed25519 key, later signed
+// requests don't need to carry the API key and signature
+func (c *WsAPIClient) SessionLogon(ctx context.Context, opts ...RequestOption) (res *WsAPISessionStatus, err error) {
+	return c.Logon(ctx, newWsAPIRequest(secTypeSigned, opts...).recvWindow)
+}
+
+// SessionStatus query the status of the websocket API session
+func (c *WsAPIClient) SessionStatus(ctx context.Context, opts ...RequestOption) (res *WsAPISessionStatus, err error) {
+	return c.Status(ctx, newWsAPIRequest(secTypeNone, opts...).recvWindow)
+}
+
+// SessionLogout forget the API key of the session, the connection stays open
+func (c *WsAPIClient) SessionLogout(ctx context.Context, opts ...RequestOption) (res *WsAPISessionStatus, err error) {
+	return c.Logout(ctx, newWsAPIRequest(secTypeNone, opts...).recvWindow)
+}
+
+// NewCreateOrderService init creating order service
+func (c *WsAPIClient) NewCreateOrderService() *WsCreateOrderService {
+	return &WsCreateOrderService{c: c}
+}
+
+// NewCancelOrderService init cancel order service
+func (c *WsAPIClient) NewCancelOrderService() *WsCancelOrderService {
+	return &WsCancelOrderService{c: c}
+}
+
+// NewGetOrderService init get order service
+func (c *WsAPIClient) NewGetOrderService() *WsGetOrderService {
+	return &WsGetOrderService{c: c}
+}
+
+// NewListOpenOrdersService init list open orders service
+func (c *WsAPIClient) NewListOpenOrdersService() *WsListOpenOrdersService {
+	return &WsListOpenOrdersService{c: c}
+}
+
+// NewGetAccountService init getting account service
+func (c *WsAPIClient) NewGetAccountService() *WsGetAccountService {
+	return &WsGetAccountService{c: c}
+}
+
+// WsCreateOrderService create order through the websocket API, it takes the same parameters as CreateOrderService
+type WsCreateOrderService struct {
+	c *WsAPIClient
+	s CreateOrderService
+}
+
+// Symbol set symbol
+func (s *WsCreateOrderService) Symbol(symbol string) *WsCreateOrderService {
+	s.s.Symbol(symbol)
+	return s
+}
+
+// Side set side
+func (s *WsCreateOrderService) Side(side SideType) *WsCreateOrderService {
+	s.s.Side(side)
+	return s
+}
+
+// Type set type
+func (s *WsCreateOrderService) Type(orderType OrderType) *WsCreateOrderService {
+	s.s.Type(orderType)
+	return s
+}
+
+// TimeInForce set timeInForce
+func (s *WsCreateOrderService) TimeInForce(timeInForce TimeInForceType) *WsCreateOrderService {
+	s.s.TimeInForce(timeInForce)
+	return s
+}
+
+// Quantity set quantity
+func (s *WsCreateOrderService) Quantity(quantity string) *WsCreateOrderService {
+	s.s.Quantity(quantity)
+	return s
+}
+
+// QuoteOrderQty set quoteOrderQty
+func (s *WsCreateOrderService) QuoteOrderQty(quoteOrderQty string) *WsCreateOrderService {
+	s.s.QuoteOrderQty(quoteOrderQty)
+	return s
+}
+
+// Price set price
+func (s *WsCreateOrderService) Price(price string) *WsCreateOrderService {
+	s.s.Price(price)
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *WsCreateOrderService) NewClientOrderID(newClientOrderID string) *WsCreateOrderService {
+	s.s.NewClientOrderID(newClientOrderID)
+	return s
+}
+
+// StopPrice set stopPrice
+func (s *WsCreateOrderService) StopPrice(stopPrice string) *WsCreateOrderService {
+	s.s.StopPrice(stopPrice)
+	return s
+}
+
+// TrailingDelta set trailingDelta
+func (s *WsCreateOrderService) TrailingDelta(trailingDelta string) *WsCreateOrderService {
+	s.s.TrailingDelta(trailingDelta)
+	return s
+}
+
+// IcebergQuantity set icebergQuantity
+func (s *WsCreateOrderService) IcebergQuantity(icebergQuantity string) *WsCreateOrderService {
+	s.s.IcebergQuantity(icebergQuantity)
+	return s
+}
+
+// NewOrderRespType set newOrderRespType
+func (s *WsCreateOrderService) NewOrderRespType(newOrderRespType NewOrderRespType) *WsCreateOrderService {
+	s.s.NewOrderRespType(newOrderRespType)
+	return s
+}
+
+// StrategyID set strategyId
+func (s *WsCreateOrderService) StrategyID(strategyID int64) *WsCreateOrderService {
+	s.s.StrategyID(strategyID)
+	return s
+}
+
+// StrategyType set strategyType
+func (s *WsCreateOrderService) StrategyType(strategyType int64) *WsCreateOrderService {
+	s.s.StrategyType(strategyType)
+	return s
+}
+
+// SelfTradePreventionMode set selfTradePreventionMode
+func (s *WsCreateOrderService) SelfTradePreventionMode(mode STPModeType) *WsCreateOrderService {
+	s.s.SelfTradePreventionMode(mode)
+	return s
+}
+
+// Do send request
+func (s *WsCreateOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CreateOrderResponse, err error) {
+	res = new(CreateOrderResponse)
+	err = s.c.callAPI(ctx, "order.place", s.s.buildParams(), secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Test send test request to check if the order is valid
+func (s *WsCreateOrderService) Test(ctx context.Context, opts ...RequestOption) (err error) {
+	_, err = s.c.call(ctx, "order.test", s.s.buildParams(), secTypeSigned, opts...)
+	return err
+}
+
+// WsCancelOrderService cancel an order through the websocket API
+type WsCancelOrderService struct {
+	c *WsAPIClient
+	s CancelOrderService
+}
+
+// Symbol set symbol
+func (s *WsCancelOrderService) Symbol(symbol string) *WsCancelOrderService {
+	s.s.Symbol(symbol)
+	return s
+}
+
+// OrderID set orderID
+func (s *WsCancelOrderService) OrderID(orderID int64) *WsCancelOrderService {
+	s.s.OrderID(orderID)
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *WsCancelOrderService) OrigClientOrderID(origClientOrderID string) *WsCancelOrderService {
+	s.s.OrigClientOrderID(origClientOrderID)
+	return s
+}
+
+// NewClientOrderID set newClientOrderID
+func (s *WsCancelOrderService) NewClientOrderID(newClientOrderID string) *WsCancelOrderService {
+	s.s.NewClientOrderID(newClientOrderID)
+	return s
+}
+
+// Do send request
+func (s *WsCancelOrderService) Do(ctx context.Context, opts ...RequestOption) (res *CancelOrderResponse, err error) {
+	res = new(CancelOrderResponse)
+	err = s.c.callAPI(ctx, "order.cancel", s.s.buildParams(), secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WsGetOrderService get an order through the websocket API
+type WsGetOrderService struct {
+	c *WsAPIClient
+	s GetOrderService
+}
+
+// Symbol set symbol
+func (s *WsGetOrderService) Symbol(symbol string) *WsGetOrderService {
+	s.s.Symbol(symbol)
+	return s
+}
+
+// OrderID set orderID
+func (s *WsGetOrderService) OrderID(orderID int64) *WsGetOrderService {
+	s.s.OrderID(orderID)
+	return s
+}
+
+// OrigClientOrderID set origClientOrderID
+func (s *WsGetOrderService) OrigClientOrderID(origClientOrderID string) *WsGetOrderService {
+	s.s.OrigClientOrderID(origClientOrderID)
+	return s
+}
+
+// Do send request
+func (s *WsGetOrderService) Do(ctx context.Context, opts ...RequestOption) (res *Order, err error) {
+	res = new(Order)
+	err = s.c.callAPI(ctx, "order.status", s.s.buildParams(), secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// WsListOpenOrdersService list opened orders through the websocket API
+type WsListOpenOrdersService struct {
+	c      *WsAPIClient
+	symbol string
+}
+
+// Symbol set symbol
+func (s *WsListOpenOrdersService) Symbol(symbol string) *WsListOpenOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// Do send request
+func (s *WsListOpenOrdersService) Do(ctx context.Context, opts ...RequestOption) (res []*Order, err error) {
+	m := params{}
+	if s.symbol != "" {
+		m["symbol"] = s.symbol
+	}
+	res = make([]*Order, 0)
+	err = s.c.callAPI(ctx, "openOrders.status", m, secTypeSigned, &res, opts...)
+	if err != nil {
+		return []*Order{}, err
+	}
+	return res, nil
+}
+
+// WsGetAccountService get account info through the websocket API
+type WsGetAccountService struct {
+	c *WsAPIClient
+}
+
+// Do send request
+func (s *WsGetAccountService) Do(ctx context.Context, opts ...RequestOption) (res *Account, err error) {
+	res = new(Account)
+	err = s.c.callAPI(ctx, "account.status", params{}, secTypeSigned, res, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
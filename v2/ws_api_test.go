@@ -0,0 +1,307 @@
+package binance
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	stdjson "encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/adshao/go-binance/v2/common"
+)
+
+type wsAPIServerRequest struct {
+	ID     string                 `json:"id"`
+	Method string                 `json:"method"`
+	Params map[string]interface{} `json:"params"`
+}
+
+// wsAPIServer is a local stand-in of the websocket API, respond builds the
+// response payload of each request
+type wsAPIServer struct {
+	*httptest.Server
+	mu       sync.Mutex
+	requests []*wsAPIServerRequest
+	respond  func(conn *websocket.Conn, req *wsAPIServerRequest)
+}
+
+func newWsAPIServer() *wsAPIServer {
+	s := &wsAPIServer{}
+	upgrader := websocket.Upgrader{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			req := new(wsAPIServerRequest)
+			if err := conn.ReadJSON(req); err != nil {
+				return
+			}
+			s.mu.Lock()
+			s.requests = append(s.requests, req)
+			s.mu.Unlock()
+			s.respond(conn, req)
+		}
+	}))
+	return s
+}
+
+func (s *wsAPIServer) endpoint() string {
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func writeWsAPIResult(conn *websocket.Conn, id string, result string) {
+	conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"id":"%s","status":200,"result":%s}`, id, result)))
+}
+
+type wsAPITestSuite struct {
+	suite.Suite
+	server *wsAPIServer
+	client *WsAPIClient
+}
+
+func TestWsAPIClient(t *testing.T) {
+	suite.Run(t, new(wsAPITestSuite))
+}
+
+func (s *wsAPITestSuite) SetupTest() {
+	s.server = newWsAPIServer()
+	s.client = NewWsAPIClient("dummyAPIKey", "dummySecretKey")
+	s.client.Endpoint = s.server.endpoint()
+}
+
+func (s *wsAPITestSuite) TearDownTest() {
+	s.client.Close()
+	s.server.Close()
+}
+
+func (s *wsAPITestSuite) TestNotConnected() {
+	_, err := s.client.NewGetAccountService().Do(newContext())
+	s.Require().Equal(ErrWsAPINotConnected, err)
+}
+
+func (s *wsAPITestSuite) TestCreateOrder() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		writeWsAPIResult(conn, req.ID, `{
+			"symbol": "BTCUSDT",
+			"orderId": 12569099453,
+			"orderListId": -1,
+			"clientOrderId": "4d96324ff9d44481926157ec08158a40",
+			"transactTime": 1660801715639,
+			"price": "23416.10000000",
+			"origQty": "0.00847000",
+			"executedQty": "0.00000000",
+			"cummulativeQuoteQty": "0.00000000",
+			"status": "NEW",
+			"timeInForce": "GTC",
+			"type": "LIMIT",
+			"side": "SELL"
+		}`)
+	}
+	r.NoError(s.client.Connect())
+
+	res, err := s.client.NewCreateOrderService().Symbol("BTCUSDT").Side(SideTypeSell).
+		Type(OrderTypeLimit).TimeInForce(TimeInForceTypeGTC).Price("23416.10000000").
+		Quantity("0.00847000").NewClientOrderID("4d96324ff9d44481926157ec08158a40").
+		Do(newContext(), WithRecvWindow(5000))
+	r.NoError(err)
+	r.Equal(&CreateOrderResponse{
+		Symbol:                   "BTCUSDT",
+		OrderID:                  12569099453,
+		ClientOrderID:            "4d96324ff9d44481926157ec08158a40",
+		TransactTime:             1660801715639,
+		Price:                    "23416.10000000",
+		OrigQuantity:             "0.00847000",
+		ExecutedQuantity:         "0.00000000",
+		CummulativeQuoteQuantity: "0.00000000",
+		Status:                   OrderStatusTypeNew,
+		TimeInForce:              TimeInForceTypeGTC,
+		Type:                     OrderTypeLimit,
+		Side:                     SideTypeSell,
+	}, res)
+
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+	r.Len(s.server.requests, 1)
+	req := s.server.requests[0]
+	r.Equal("order.place", req.Method)
+	r.Equal("BTCUSDT", req.Params["symbol"])
+	r.Equal("SELL", req.Params["side"])
+	r.Equal("LIMIT", req.Params["type"])
+	r.Equal("GTC", req.Params["timeInForce"])
+	r.Equal("23416.10000000", req.Params["price"])
+	r.Equal("0.00847000", req.Params["quantity"])
+	r.Equal("dummyAPIKey", req.Params["apiKey"])
+	r.EqualValues(5000, req.Params["recvWindow"])
+	r.NotEmpty(req.Params["timestamp"])
+	r.NotEmpty(req.Params["signature"])
+}
+
+func (s *wsAPITestSuite) TestAPIError() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{
+			"id": "%s",
+			"status": 400,
+			"error": {"code": -2011, "msg": "Unknown order sent."}
+		}`, req.ID)))
+	}
+	r.NoError(s.client.Connect())
+
+	_, err := s.client.NewCancelOrderService().Symbol("BTCUSDT").OrderID(1).Do(newContext())
+	r.Equal(&common.APIError{Code: -2011, Message: "Unknown order sent.", StatusCode: 400}, err)
+}
+
+func (s *wsAPITestSuite) TestConcurrentRequests() {
+	r := s.Require()
+	var mu sync.Mutex
+	var held []*wsAPIServerRequest
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		mu.Lock()
+		defer mu.Unlock()
+		held = append(held, req)
+		if len(held) < 2 {
+			return
+		}
+		// answer in reverse order
+		for i := len(held) - 1; i >= 0; i-- {
+			orderID := held[i].Params["orderId"]
+			writeWsAPIResult(conn, held[i].ID, fmt.Sprintf(`{"symbol": "BTCUSDT", "orderId": %v, "status": "FILLED"}`, orderID))
+		}
+	}
+	r.NoError(s.client.Connect())
+
+	var wg sync.WaitGroup
+	results := make([]*Order, 2)
+	errs := make([]error, 2)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = s.client.NewGetOrderService().Symbol("BTCUSDT").OrderID(int64(i + 1)).Do(newContext())
+		}(i)
+	}
+	wg.Wait()
+	for i := 0; i < 2; i++ {
+		r.NoError(errs[i])
+		r.Equal(int64(i+1), results[i].OrderID)
+		r.Equal(OrderStatusTypeFilled, results[i].Status)
+	}
+}
+
+func (s *wsAPITestSuite) TestConnectionLost() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		conn.Close()
+	}
+	r.NoError(s.client.Connect())
+
+	_, err := s.client.NewListOpenOrdersService().Symbol("BTCUSDT").Do(newContext())
+	r.Equal(ErrWsAPINotConnected, err)
+	select {
+	case <-s.client.Done():
+	case <-time.After(5 * time.Second):
+		s.T().Fatal("connection was not closed")
+	}
+}
+
+func (s *wsAPITestSuite) TestSessionLogon() {
+	r := s.Require()
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	r.NoError(err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	r.NoError(err)
+	s.client.KeyType = common.KeyTypeEd25519
+	s.client.SecretKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		switch req.Method {
+		case "session.logon":
+			keys := make([]string, 0, len(req.Params))
+			for k := range req.Params {
+				if k != "signature" {
+					keys = append(keys, k)
+				}
+			}
+			sort.Strings(keys)
+			pairs := make([]string, len(keys))
+			for i, k := range keys {
+				v := req.Params[k]
+				if n, ok := v.(float64); ok {
+					v = int64(n)
+				}
+				pairs[i] = fmt.Sprintf("%s=%v", k, v)
+			}
+			signature, _ := base64.StdEncoding.DecodeString(req.Params["signature"].(string))
+			if !ed25519.Verify(pub, []byte(strings.Join(pairs, "&")), signature) {
+				conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"id":"%s","status":400,"error":{"code":-1022,"msg":"Signature for this request is not valid."}}`, req.ID)))
+				return
+			}
+			writeWsAPIResult(conn, req.ID, `{
+				"apiKey": "dummyAPIKey",
+				"authorizedSince": 1649729878532,
+				"connectedSince": 1649729873021,
+				"returnRateLimits": false,
+				"serverTime": 1649729878630
+			}`)
+		case "account.status":
+			writeWsAPIResult(conn, req.ID, `{"canTrade": true, "balances": [{"asset": "BNB", "free": "0.00000000", "locked": "0.00000000"}]}`)
+		}
+	}
+	r.NoError(s.client.Connect())
+
+	status, err := s.client.SessionLogon(newContext())
+	r.NoError(err)
+	r.Equal("dummyAPIKey", *status.APIKey)
+	r.Equal(int64(1649729878532), *status.AuthorizedSince)
+
+	account, err := s.client.NewGetAccountService().Do(newContext())
+	r.NoError(err)
+	r.True(account.CanTrade)
+	r.Equal([]Balance{{Asset: "BNB", Free: "0.00000000", Locked: "0.00000000"}}, account.Balances)
+
+	s.server.mu.Lock()
+	defer s.server.mu.Unlock()
+	r.Len(s.server.requests, 2)
+	req := s.server.requests[1]
+	r.NotEmpty(req.Params["timestamp"])
+	r.NotContains(req.Params, "apiKey")
+	r.NotContains(req.Params, "signature")
+}
+
+func (s *wsAPITestSuite) TestSessionLogonRequiresEd25519() {
+	_, err := s.client.SessionLogon(newContext())
+	s.Require().Error(err)
+}
+
+func (s *wsAPITestSuite) TestCall() {
+	r := s.Require()
+	s.server.respond = func(conn *websocket.Conn, req *wsAPIServerRequest) {
+		writeWsAPIResult(conn, req.ID, `{"serverTime": 1656400526260}`)
+	}
+	r.NoError(s.client.Connect())
+
+	res, err := s.client.Call(newContext(), "time", nil, false)
+	r.NoError(err)
+	r.Equal(200, res.Status)
+	var serverTime struct {
+		ServerTime int64 `json:"serverTime"`
+	}
+	r.NoError(stdjson.Unmarshal(res.Result, &serverTime))
+	r.Equal(int64(1656400526260), serverTime.ServerTime)
+}